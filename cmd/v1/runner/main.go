@@ -1,5 +1,45 @@
 package main
 
-func main() {
+import (
+	"flag"
+	"os"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/runner"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"github.com/nevercase/k8s-controller-custom-resource/pkg/signals"
+	"k8s.io/klog/v2"
+)
 
+func main() {
+	var configPath = flag.String("configPath", "conf.yaml", "configuration file path")
+	klog.InitFlags(nil)
+	flag.Parse()
+	defer klog.Flush()
+	stopCh := signals.SetupSignalHandler()
+	c := conf.InitRunner(*configPath)
+	ops, err := runner.NewStepOperators(c.StepOperators)
+	if err != nil {
+		klog.Fatal(err)
+	}
+	hostname := c.Runner.Hostname
+	if hostname == "" {
+		if hostname, err = os.Hostname(); err != nil {
+			klog.Fatal(err)
+		}
+	}
+	r := &runner.Runner{
+		Name:          c.Runner.Name,
+		Hostname:      hostname,
+		Namespace:     types.Namespace(c.Runner.Namespace),
+		GroupName:     types.GroupName(c.Runner.GroupName),
+		StepOperators: ops,
+		Labels:        c.Runner.Labels,
+	}
+	client, err := runner.NewClient(c.Scheduler.Addr, make(chan *types.LogStreamRequest, 4096), r, c.Runner.SpoolDir)
+	if err != nil {
+		klog.Fatal(err)
+	}
+	<-stopCh
+	client.Shutdown()
 }
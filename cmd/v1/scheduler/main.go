@@ -19,6 +19,7 @@ func main() {
 	defer zaplogger.Sync()
 	stopCh := signals.SetupSignalHandler()
 	s := scheduler.NewServer(conf.Init(*configPath), "")
+	go conf.Watch(*configPath, stopCh, s.Reload)
 	<-stopCh
 	s.Shutdown()
 	<-stopCh
@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"path"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"github.com/gorilla/websocket"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ErrClientWasClosed = "error: client was closed err:%v"
+)
+
+// Message was the protobuf message which was carried by the Data of the Request and the Response
+type Message interface {
+	Marshal() ([]byte, error)
+	Unmarshal(data []byte) error
+}
+
+// Error was the failed Response of a Call
+type Error struct {
+	Code    int32
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("code:%d message:%s", e.Code, e.Message)
+}
+
+// Client was a dashboard connection to the Scheduler, it offers the synchronous Call on top of the websocket
+type Client struct {
+	writeMu sync.Mutex
+	conn    *websocket.Conn
+	seq     int64
+	mu      sync.Mutex
+	pending map[string]chan *types.Response
+	// err was the reason why the Client was closed
+	err         error
+	onBroadcast func(res *types.Response)
+	ctx         context.Context
+	cancel      context.CancelFunc
+}
+
+// DialOption sets the optional attributes of the dialing
+type DialOption func(d *dialer)
+
+type dialer struct {
+	websocket.Dialer
+	scheme string
+}
+
+// WithTLS dials the Scheduler by the wss with the config, the nil config was the default one of the crypto/tls
+func WithTLS(config *tls.Config) DialOption {
+	return func(d *dialer) {
+		d.scheme = "wss"
+		d.TLSClientConfig = config
+	}
+}
+
+// Dial connects to the Scheduler at the addr as a dashboard, it was dialed by the ws unless the WithTLS was used.
+// The onBroadcast would be called with the Responses which weren't the replies of the Calls, it could be nil.
+func Dial(ctx context.Context, addr, token string, onBroadcast func(res *types.Response), opts ...DialOption) (*Client, error) {
+	d := &dialer{Dialer: *websocket.DefaultDialer, scheme: "ws"}
+	for _, opt := range opts {
+		opt(d)
+	}
+	u := url.URL{Scheme: d.scheme, Host: addr, Path: path.Join(path.Dir(types.WebsocketHandlerDashboard), token)}
+	conn, _, err := d.DialContext(ctx, u.String(), nil)
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	sub, cancel := context.WithCancel(context.Background())
+	c := &Client{
+		conn:        conn,
+		pending:     make(map[string]chan *types.Response, 0),
+		onBroadcast: onBroadcast,
+		ctx:         sub,
+		cancel:      cancel,
+	}
+	go c.readPump()
+	go c.ping()
+	return c, nil
+}
+
+// Call sends the req by the ServiceAPI and waits for the reply, the reply would be unmarshalled into the res if it wasn't nil.
+// It returns an *Error if the Scheduler failed the Request.
+func (c *Client) Call(ctx context.Context, api types.ServiceAPI, req, res Message) error {
+	data, err := req.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	id := strconv.FormatInt(atomic.AddInt64(&c.seq, 1), 10)
+	reply := make(chan *types.Response, 1)
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return fmt.Errorf(ErrClientWasClosed, c.err)
+	}
+	c.pending[id] = reply
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+	if err = c.write(api, id, data); err != nil {
+		return err
+	}
+	select {
+	case r := <-reply:
+		if r.Code != types.CodeOK {
+			return &Error{Code: r.Code, Message: r.Message}
+		}
+		if res == nil {
+			return nil
+		}
+		return res.Unmarshal(r.Data)
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.ctx.Done():
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return fmt.Errorf(ErrClientWasClosed, c.err)
+	}
+}
+
+func (c *Client) write(api types.ServiceAPI, id string, data []byte) error {
+	req := &types.Request{
+		Type: types.Type{
+			Body:       types.BodyDashboard,
+			ServiceAPI: api,
+		},
+		Data:      data,
+		Id:        id,
+		Timestamp: time.Now().UnixNano() / int64(time.Millisecond),
+	}
+	msg, err := req.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err = c.conn.WriteMessage(websocket.BinaryMessage, msg); err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	return nil
+}
+
+func (c *Client) readPump() {
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			klog.V(2).Info(err)
+			c.close(err)
+			return
+		}
+		res := &types.Response{}
+		if err = res.Unmarshal(msg); err != nil {
+			klog.V(2).Info(err)
+			continue
+		}
+		if res.Broadcast {
+			if c.onBroadcast != nil {
+				c.onBroadcast(res)
+			}
+			continue
+		}
+		// the reply of the Ping or the Call which had timed out would be dropped
+		c.mu.Lock()
+		reply, ok := c.pending[res.Id]
+		c.mu.Unlock()
+		if ok {
+			select {
+			case reply <- res:
+			default:
+			}
+		}
+	}
+}
+
+// ping keeps the connection alive before the Scheduler timed it out
+func (c *Client) ping() {
+	tick := time.NewTicker(time.Second * time.Duration(types.WebsocketConnectionTimeout/2))
+	defer tick.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-tick.C:
+			if err := c.write(types.Ping, "", nil); err != nil {
+				klog.V(2).Info(err)
+			}
+		}
+	}
+}
+
+func (c *Client) close(err error) {
+	c.mu.Lock()
+	if c.err == nil {
+		c.err = err
+	}
+	c.mu.Unlock()
+	c.cancel()
+}
+
+// Close closes the connection, the pending Calls would return an error
+func (c *Client) Close() error {
+	c.close(context.Canceled)
+	return c.conn.Close()
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"github.com/gorilla/websocket"
+)
+
+// newTestScheduler answers the ListNamespace with ns1 after a broadcast, and fails the other Requests.
+// The server would be served by the TLS if the secure was true
+func newTestScheduler(t *testing.T, secure bool) *httptest.Server {
+	upGrader := websocket.Upgrader{}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upGrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			req := &types.Request{}
+			if err = req.Unmarshal(msg); err != nil {
+				t.Error(err)
+				return
+			}
+			res := []*types.Response{
+				{Type: types.Type{ServiceAPI: types.UpdateStep}, Id: req.Id, Broadcast: true},
+				{Type: req.Type, Id: req.Id, Timestamp: req.Timestamp},
+			}
+			switch req.Type.ServiceAPI {
+			case types.ListNamespace:
+				res[1].Data, _ = (&types.ListNamespaceResponse{Items: []string{"ns1"}}).Marshal()
+			case types.Ping:
+				continue
+			default:
+				res[1].Code, res[1].Message = types.CodeNotFound, "not existed"
+			}
+			for _, v := range res {
+				data, _ := v.Marshal()
+				if err = conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	if secure {
+		server.StartTLS()
+	} else {
+		server.Start()
+	}
+	return server
+}
+
+func TestDial(t *testing.T) {
+	tests := []struct {
+		name    string
+		secure  bool
+		withTLS bool
+		wantErr bool
+	}{
+		{
+			name:    "TestDial_1",
+			secure:  false,
+			withTLS: false,
+			wantErr: false,
+		},
+		{
+			name:    "TestDial_2",
+			secure:  true,
+			withTLS: true,
+			wantErr: false,
+		},
+		{
+			name:    "TestDial_3",
+			secure:  true,
+			withTLS: false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestScheduler(t, tt.secure)
+			defer server.Close()
+			opts := make([]DialOption, 0)
+			if tt.withTLS {
+				opts = append(opts, WithTLS(server.Client().Transport.(*http.Transport).TLSClientConfig))
+			}
+			addr := strings.TrimPrefix(strings.TrimPrefix(server.URL, "http://"), "https://")
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			c, err := Dial(ctx, addr, "token", nil, opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Dial() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer c.Close()
+			if err = c.Call(ctx, types.ListNamespace, &types.ListNamespaceRequest{}, &types.ListNamespaceResponse{}); err != nil {
+				t.Errorf("Call() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_Call(t *testing.T) {
+	server := newTestScheduler(t, false)
+	defer server.Close()
+	broadcasts := make(chan *types.Response, 10)
+	c, err := Dial(context.Background(), strings.TrimPrefix(server.URL, "http://"), "token", func(res *types.Response) {
+		broadcasts <- res
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	tests := []struct {
+		name     string
+		api      types.ServiceAPI
+		want     *types.ListNamespaceResponse
+		wantCode int32
+	}{
+		{
+			name: "TestClient_Call_1",
+			api:  types.ListNamespace,
+			want: &types.ListNamespaceResponse{Items: []string{"ns1"}},
+		},
+		{
+			name:     "TestClient_Call_2",
+			api:      types.ListRunner,
+			wantCode: types.CodeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			res := &types.ListNamespaceResponse{}
+			err := c.Call(ctx, tt.api, &types.ListNamespaceRequest{}, res)
+			if tt.wantCode != types.CodeOK {
+				if e, ok := err.(*Error); !ok || e.Code != tt.wantCode {
+					t.Errorf("Call() error = %v, wantCode %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Call() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(res, tt.want) {
+				t.Errorf("Call() res = %v, want %v", res, tt.want)
+			}
+			select {
+			case b := <-broadcasts:
+				if b.Type.ServiceAPI != types.UpdateStep {
+					t.Errorf("broadcast = %v, want UpdateStep", b.Type.ServiceAPI)
+				}
+			case <-ctx.Done():
+				t.Errorf("broadcast wasn't received")
+			}
+		})
+	}
+}
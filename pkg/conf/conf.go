@@ -15,6 +15,40 @@ type Config struct {
 	PublisherService PublisherService    `yaml:"PublisherService,flow"`
 	Mysql            dao.MysqlPoolConfig `yaml:"Mysql,flow"`
 	Projects         []Project           `yaml:"Projects"`
+	// Permissions grant the dashboard tokens to manage the namespaces and the groups at runtime
+	Permissions []Permission `yaml:"Permissions"`
+	// Approvers were the dashboard users who could approve the gated Steps
+	Approvers []Approver `yaml:"Approvers"`
+	// HA elects the leader among the Schedulers which shared the same MySQL
+	HA HA `yaml:"HA"`
+}
+
+// HA was disabled if the Id was empty. The followers proxy all the requests and the connections to the leader,
+// and the one which took over the lease would load the state which was saved in the MySQL by the previous leader.
+type HA struct {
+	// Id was the unique name of the Scheduler instance
+	Id string `yaml:"id"`
+	// AdvertiseAddr was the url which the followers proxy to when it was the leader, such as `http://10.0.0.1:6969`
+	AdvertiseAddr string `yaml:"advertiseAddr"`
+	// LeaseDurationInSec was the duration which the lease could be kept without renewing, the default one was 15
+	LeaseDurationInSec int `yaml:"leaseDurationInSec"`
+	// RenewIntervalInSec must be less than the LeaseDurationInSec, the default one was 5
+	RenewIntervalInSec int `yaml:"renewIntervalInSec"`
+}
+
+// Permission allows the Token to create, rename and delete the Namespaces and their groups,
+// the `*` allows all the namespaces
+type Permission struct {
+	Token      string   `yaml:"token"`
+	Namespaces []string `yaml:"namespaces"`
+}
+
+// Approver allows the User who connected with the Token to approve the gated Steps of the Namespaces,
+// the `*` allows all the namespaces
+type Approver struct {
+	User       string   `yaml:"user"`
+	Token      string   `yaml:"token"`
+	Namespaces []string `yaml:"namespaces"`
 }
 
 type Project struct {
@@ -24,17 +58,60 @@ type Project struct {
 
 type Group struct {
 	Name string `yaml:"name"`
+	// Mode was empty or `pool`, the Runners in a pool Group would share the Steps which were run without the runner name
+	Mode string `yaml:"mode"`
+	// Duplicate was the policy of the Runners which registered with the name of a registered one.
+	// It was empty to reject the newcomer until the registered one disconnected, `replace` to close the registered one,
+	// or `pool` to keep both and send each Step to one of them in turn.
+	Duplicate string `yaml:"duplicate"`
+	// Pipeline declares the dependencies between the (runner, step) pairs of the Group,
+	// the Steps wouldn't be triggered automatically by the StepPolicyAuto anymore when it was declared.
+	Pipeline []PipelineNode `yaml:"pipeline"`
+	// Hooks were the inbound webhooks which could be called at `/hooks/:namespace/:group/:name`
+	Hooks []Hook `yaml:"hooks"`
+	// Approvals were the numbers of the distinct Approvers which the Steps must be approved by before running, by the step names
+	Approvals map[string]int `yaml:"approvals"`
+}
+
+// Hook maps the push payloads of GitHub, GitLab and Gitea, or a generic JSON payload, to a run of the Step.
+// The pipeline of the Group would be run if the Step was empty.
+type Hook struct {
+	Name string `yaml:"name"`
+	// Secret was the HMAC secret of GitHub, Gitea and the generic payloads, or the token of GitLab
+	Secret string `yaml:"secret"`
+	Runner string `yaml:"runner"`
+	Step   string `yaml:"step"`
+	// Branches were the patterns of the pushed branches such as `release/*`, all the branches would be accepted if it was empty
+	Branches []string `yaml:"branches"`
+	// Envs map the keys of the Step's Envs to the dotted paths of the payload fields, such as `VERSION: release.version`
+	Envs map[string]string `yaml:"envs"`
+}
+
+// PipelineNode would be started after all the nodes in the DependsOn succeeded
+type PipelineNode struct {
+	Name      string   `yaml:"name"`
+	Runner    string   `yaml:"runner"`
+	Step      string   `yaml:"step"`
+	DependsOn []string `yaml:"dependsOn"`
 }
 
 func Init(file string) *Config {
-	c := &Config{}
-	var data []byte
-	var err error
-	if data, err = ioutil.ReadFile(file); err != nil {
-		klog.Fatal(err)
-	}
-	if err := yaml.Unmarshal(data, c); err != nil {
+	c, err := Load(file)
+	if err != nil {
 		klog.Fatal(err)
 	}
 	return c
 }
+
+// Load parses the configuration file, it wouldn't exit the process like the Init when the file was invalid
+func Load(file string) (*Config, error) {
+	c := &Config{}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	if err = yaml.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,49 @@
+package conf
+
+import (
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"k8s.io/klog"
+)
+
+type Scheduler struct {
+	// Addr was the host:port of the Scheduler which the Runner would connect to
+	Addr string `yaml:"addr"`
+}
+
+type Runner struct {
+	Name      string `yaml:"name"`
+	Hostname  string `yaml:"hostname"`
+	Namespace string `yaml:"namespace"`
+	GroupName string `yaml:"groupName"`
+	// Labels describe the capabilities of the Runner, the dashboard could target the Runner by a label selector
+	Labels map[string]string `yaml:"labels"`
+	// SpoolDir was the directory where the output lines were spooled before being acknowledged by the Scheduler
+	SpoolDir string `yaml:"spoolDir"`
+}
+
+// StepOperator was the declaration of an interfaces.StepOperator which would be built by the Runner.
+// The Params were different between the types, such as `dir` and `branch` for the git operator.
+type StepOperator struct {
+	Type   string            `yaml:"type"`
+	Params map[string]string `yaml:"params"`
+}
+
+type RunnerConfig struct {
+	Scheduler     Scheduler      `yaml:"Scheduler,flow"`
+	Runner        Runner         `yaml:"Runner,flow"`
+	StepOperators []StepOperator `yaml:"StepOperators"`
+}
+
+func InitRunner(file string) *RunnerConfig {
+	c := &RunnerConfig{}
+	var data []byte
+	var err error
+	if data, err = ioutil.ReadFile(file); err != nil {
+		klog.Fatal(err)
+	}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		klog.Fatal(err)
+	}
+	return c
+}
@@ -0,0 +1,55 @@
+package conf
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"k8s.io/klog"
+)
+
+const (
+	// WatchInterval was the interval of checking whether the configuration file was modified
+	WatchInterval = time.Second * 5
+)
+
+// Watch calls the onChange with the re-parsed configuration when the process received SIGHUP or the file was modified.
+// The configuration which couldn't be parsed would be skipped, and the running one would be kept.
+func Watch(file string, stopCh <-chan struct{}, onChange func(c *Config)) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	tick := time.NewTicker(WatchInterval)
+	defer tick.Stop()
+	modTime := modTimeOf(file)
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-hup:
+			klog.Infof("reload the configuration:%s by SIGHUP", file)
+		case <-tick.C:
+			if t := modTimeOf(file); t.Equal(modTime) {
+				continue
+			}
+			klog.Infof("reload the modified configuration:%s", file)
+		}
+		modTime = modTimeOf(file)
+		c, err := Load(file)
+		if err != nil {
+			klog.Errorf("the configuration:%s wasn't reloaded err:%v", file, err)
+			continue
+		}
+		onChange(c)
+	}
+}
+
+// modTimeOf returns the zero time if the file couldn't be read, such as it was being replaced
+func modTimeOf(file string) time.Time {
+	fi, err := os.Stat(file)
+	if err != nil {
+		return time.Time{}
+	}
+	return fi.ModTime()
+}
@@ -12,3 +12,38 @@ CREATE TABLE records (
     stepType TINYINT(1) DEFAULT 0 COMMENT '步骤类型',
     createdTM INT(11) NOT NULL
 );
+
+CREATE TABLE runner_states (
+    namespace VARCHAR(128) NOT NULL COMMENT 'namespace项目命名空间',
+    groupName VARCHAR(128) NOT NULL COMMENT '项目分支渠道名称',
+    runnerName VARCHAR(128) NOT NULL COMMENT 'runner名称',
+    PRIMARY KEY(namespace, groupName, runnerName),
+    runnerInfo BLOB COMMENT 'runner及其全部步骤的最新状态',
+    updatedTM INT(11) NOT NULL
+);
+
+CREATE TABLE schedules (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    PRIMARY KEY(id),
+    namespace VARCHAR(128) DEFAULT '' COMMENT 'namespace项目命名空间',
+    groupName VARCHAR(128) DEFAULT '' COMMENT '项目分支渠道名称',
+    scheduleInfo BLOB COMMENT '定时任务完整信息',
+    updatedTM INT(11) NOT NULL
+);
+
+CREATE TABLE project_groups (
+    namespace VARCHAR(128) NOT NULL COMMENT 'namespace项目命名空间',
+    groupName VARCHAR(128) NOT NULL DEFAULT '' COMMENT '项目分支渠道名称, 为空时表示命名空间本身',
+    PRIMARY KEY(namespace, groupName),
+    mode VARCHAR(32) DEFAULT '' COMMENT '分组模式',
+    duplicate VARCHAR(32) DEFAULT '' COMMENT '同名runner的处理策略',
+    createdTM INT(11) NOT NULL
+);
+
+CREATE TABLE leases (
+    name VARCHAR(128) NOT NULL COMMENT '租约名称',
+    PRIMARY KEY(name),
+    holder VARCHAR(128) NOT NULL DEFAULT '' COMMENT '持有租约的scheduler实例id',
+    addr VARCHAR(256) NOT NULL DEFAULT '' COMMENT '持有者对外的访问地址',
+    renewedTM INT(11) NOT NULL
+);
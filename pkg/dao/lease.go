@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// Lease was a row of the leases table, the Holder was the leader until the RenewedTM plus the lease duration
+type Lease struct {
+	Name      string
+	Holder    string
+	Addr      string
+	RenewedTM int64
+}
+
+// AcquireLease takes or renews the lease for the holder if it was free, expired or held by the holder itself.
+// It returns the current Lease whether it was acquired or not, the time was compared by the clock of the MySQL.
+func (d *Dao) AcquireLease(name, holder, addr string, duration time.Duration) (*Lease, error) {
+	tx, err := d.Mysql.Master().BeginTx(context.Background(), nil)
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	var now int64
+	if err = tx.QueryRow("SELECT UNIX_TIMESTAMP()").Scan(&now); err != nil {
+		klog.V(2).Info(err)
+		_ = tx.Rollback()
+		return nil, err
+	}
+	l := &Lease{Name: name}
+	err = tx.QueryRow("SELECT `holder`,`addr`,`renewedTM` FROM leases WHERE `name` = ? FOR UPDATE", name).Scan(&l.Holder, &l.Addr, &l.RenewedTM)
+	switch {
+	case err == sql.ErrNoRows:
+		_, err = tx.Exec("INSERT INTO leases (`name`,`holder`,`addr`,`renewedTM`) values (?,?,?,?)", name, holder, addr, now)
+	case err != nil:
+	case l.Holder == holder || l.RenewedTM+int64(duration/time.Second) <= now:
+		_, err = tx.Exec("UPDATE leases SET `holder` = ?, `addr` = ?, `renewedTM` = ? WHERE `name` = ?", holder, addr, now, name)
+	default:
+		// the lease was held by the others
+		if err = tx.Rollback(); err != nil {
+			klog.V(2).Info(err)
+		}
+		return l, nil
+	}
+	if err != nil {
+		klog.V(2).Info(err)
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	l.Holder, l.Addr, l.RenewedTM = holder, addr, now
+	return l, nil
+}
+
+// ReleaseLease expires the lease if it was held by the holder, so that the others could take it at once
+func (d *Dao) ReleaseLease(name, holder string) error {
+	if _, err := d.Mysql.Master().Exec("UPDATE leases SET `renewedTM` = 0 WHERE `name` = ? AND `holder` = ?", name, holder); err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	return nil
+}
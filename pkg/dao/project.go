@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// ProjectRow was a row of the project_groups table, the GroupName was empty for the namespace itself
+type ProjectRow struct {
+	Namespace string
+	GroupName string
+	Mode      string
+	Duplicate string
+}
+
+func (d *Dao) InsertProject(namespace, groupName, mode, duplicate string) error {
+	_, err := d.Mysql.Master().Exec("INSERT INTO project_groups (`namespace`,`groupName`,`mode`,`duplicate`,`createdTM`) values (?,?,?,?,?)",
+		namespace,
+		groupName,
+		mode,
+		duplicate,
+		time.Now().Unix())
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	return nil
+}
+
+// RenameProjectNamespace renames the namespace and all of its groups with the states of their Runners
+func (d *Dao) RenameProjectNamespace(namespace, newName string) error {
+	return d.execTx(
+		statement{"UPDATE project_groups SET `namespace` = ? WHERE `namespace` = ?", []interface{}{newName, namespace}},
+		statement{"UPDATE runner_states SET `namespace` = ? WHERE `namespace` = ?", []interface{}{newName, namespace}},
+	)
+}
+
+// RenameProjectGroup renames the group with the states of its Runners
+func (d *Dao) RenameProjectGroup(namespace, groupName, newName string) error {
+	return d.execTx(
+		statement{"UPDATE project_groups SET `groupName` = ? WHERE `namespace` = ? AND `groupName` = ?", []interface{}{newName, namespace, groupName}},
+		statement{"UPDATE runner_states SET `groupName` = ? WHERE `namespace` = ? AND `groupName` = ?", []interface{}{newName, namespace, groupName}},
+	)
+}
+
+// DeleteProjectNamespace deletes the namespace and all of its groups with the states of their Runners
+func (d *Dao) DeleteProjectNamespace(namespace string) error {
+	return d.execTx(
+		statement{"DELETE FROM project_groups WHERE `namespace` = ?", []interface{}{namespace}},
+		statement{"DELETE FROM runner_states WHERE `namespace` = ?", []interface{}{namespace}},
+	)
+}
+
+// DeleteProjectGroup deletes the group with the states of its Runners
+func (d *Dao) DeleteProjectGroup(namespace, groupName string) error {
+	return d.execTx(
+		statement{"DELETE FROM project_groups WHERE `namespace` = ? AND `groupName` = ?", []interface{}{namespace, groupName}},
+		statement{"DELETE FROM runner_states WHERE `namespace` = ? AND `groupName` = ?", []interface{}{namespace, groupName}},
+	)
+}
+
+// statement was the query with its args which would be executed in a transaction
+type statement struct {
+	query string
+	args  []interface{}
+}
+
+// execTx executes the statements in a transaction, none of them would be applied if any one failed
+func (d *Dao) execTx(statements ...statement) error {
+	tx, err := d.Mysql.Master().BeginTx(context.Background(), nil)
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	for _, v := range statements {
+		if _, err = tx.Exec(v.query, v.args...); err != nil {
+			klog.V(2).Info(err)
+			_ = tx.Rollback()
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	return nil
+}
+
+// ListProjects returns the namespaces before their groups
+func (d *Dao) ListProjects() ([]ProjectRow, error) {
+	rows, err := d.Mysql.Master().Query("SELECT `namespace`,`groupName`,`mode`,`duplicate` FROM project_groups ORDER BY `namespace`, `groupName`")
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	defer rows.Close()
+	res := make([]ProjectRow, 0)
+	for rows.Next() {
+		row := ProjectRow{}
+		if err = rows.Scan(&row.Namespace, &row.GroupName, &row.Mode, &row.Duplicate); err != nil {
+			klog.V(2).Info(err)
+			return nil, err
+		}
+		res = append(res, row)
+	}
+	return res, nil
+}
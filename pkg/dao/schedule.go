@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// ScheduleRow was a row of the schedules table, the Data was the marshaled Schedule
+type ScheduleRow struct {
+	Id        int64
+	Namespace string
+	GroupName string
+	Data      []byte
+}
+
+// InsertSchedule returns the id of the new row
+func (d *Dao) InsertSchedule(namespace, groupName string, data []byte) (int64, error) {
+	res, err := d.Mysql.Master().Exec("INSERT INTO schedules (`namespace`,`groupName`,`scheduleInfo`,`updatedTM`) values (?,?,?,?)",
+		namespace,
+		groupName,
+		data,
+		time.Now().Unix())
+	if err != nil {
+		klog.V(2).Info(err)
+		return 0, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		klog.V(2).Info(err)
+		return 0, err
+	}
+	return id, nil
+}
+
+func (d *Dao) UpdateSchedule(id int64, data []byte) error {
+	_, err := d.Mysql.Master().Exec("UPDATE schedules SET `scheduleInfo` = ?, `updatedTM` = ? WHERE `id` = ?",
+		data,
+		time.Now().Unix(),
+		id)
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	return nil
+}
+
+func (d *Dao) DeleteSchedule(id int64) error {
+	if _, err := d.Mysql.Master().Exec("DELETE FROM schedules WHERE `id` = ?", id); err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	return nil
+}
+
+// ListSchedules returns all the rows of the schedules table
+func (d *Dao) ListSchedules() ([]ScheduleRow, error) {
+	rows, err := d.Mysql.Master().Query("SELECT `id`,`namespace`,`groupName`,`scheduleInfo` FROM schedules ORDER BY id")
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	defer rows.Close()
+	res := make([]ScheduleRow, 0)
+	for rows.Next() {
+		var row ScheduleRow
+		if err = rows.Scan(&row.Id, &row.Namespace, &row.GroupName, &row.Data); err != nil {
+			klog.V(2).Info(err)
+			return nil, err
+		}
+		res = append(res, row)
+	}
+	if err = rows.Err(); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	return res, nil
+}
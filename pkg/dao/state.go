@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"database/sql"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// SaveRunnerState inserts or replaces the marshaled RunnerInfo of the specific Runner
+func (d *Dao) SaveRunnerState(namespace, groupName, runnerName string, data []byte) error {
+	_, err := d.Mysql.Master().Exec("INSERT INTO runner_states (`namespace`,`groupName`,`runnerName`,`runnerInfo`,`updatedTM`) values (?,?,?,?,?) "+
+		"ON DUPLICATE KEY UPDATE `runnerInfo` = VALUES(`runnerInfo`), `updatedTM` = VALUES(`updatedTM`)",
+		namespace,
+		groupName,
+		runnerName,
+		data,
+		time.Now().Unix())
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	return nil
+}
+
+// GetRunnerState returns the marshaled RunnerInfo of the specific Runner, the data would be nil if it wasn't saved before
+func (d *Dao) GetRunnerState(namespace, groupName, runnerName string) (data []byte, err error) {
+	err = d.Mysql.Master().QueryRow("SELECT `runnerInfo` FROM runner_states WHERE `namespace` = ? AND `groupName` = ? AND `runnerName` = ?",
+		namespace,
+		groupName,
+		runnerName).Scan(&data)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	return data, nil
+}
@@ -1,10 +1,15 @@
 package interfaces
 
-import "github.com/Shanghai-Lunara/publisher/pkg/types"
+import (
+	"context"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
 
 type StepOperator interface {
 	Step() *types.Step
 	Update(s *types.Step)
 	Prepare()
-	Run(output chan<- string) (res []string, err error)
+	// Run executes the Step, all the commands must be killed when the ctx was done
+	Run(ctx context.Context, output chan<- string) (res []string, err error)
 }
@@ -0,0 +1,50 @@
+package runner
+
+import (
+	"math/rand"
+	"time"
+)
+
+const (
+	DefaultBackoffMin    = time.Second
+	DefaultBackoffMax    = time.Second * 30
+	DefaultBackoffFactor = 2
+)
+
+// backoff computes the exponential waiting durations with jitter between the redials
+type backoff struct {
+	min     time.Duration
+	max     time.Duration
+	factor  float64
+	attempt int
+	rand    *rand.Rand
+}
+
+func newBackoff(min, max time.Duration, factor float64) *backoff {
+	return &backoff{
+		min:    min,
+		max:    max,
+		factor: factor,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Next returns the duration to wait before the next attempt.
+// The result was picked randomly between the half and the whole of the exponential duration,
+// so that all the Runners wouldn't redial the Scheduler at the same time after it restarted.
+func (b *backoff) Next() time.Duration {
+	d := float64(b.min)
+	for i := 0; i < b.attempt && d < float64(b.max); i++ {
+		d *= b.factor
+	}
+	if d > float64(b.max) {
+		d = float64(b.max)
+	}
+	b.attempt++
+	half := d / 2
+	return time.Duration(half + b.rand.Float64()*half)
+}
+
+func (b *backoff) Reset() {
+	b.attempt = 0
+}
@@ -0,0 +1,65 @@
+package runner
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_backoff_Next(t *testing.T) {
+	tests := []struct {
+		name string
+		min  time.Duration
+		max  time.Duration
+		// calls was the number of the Next before the Reset, the reset was false if the Reset wasn't called
+		calls int
+		reset bool
+		// want was the exponential duration of the last Next, the result must be between the half and the whole of it
+		want time.Duration
+	}{
+		{
+			name:  "Test_backoff_Next_1",
+			min:   time.Second,
+			max:   time.Second * 30,
+			calls: 1,
+			want:  time.Second,
+		},
+		{
+			name:  "Test_backoff_Next_2",
+			min:   time.Second,
+			max:   time.Second * 30,
+			calls: 4,
+			want:  time.Second * 8,
+		},
+		{
+			name:  "Test_backoff_Next_3",
+			min:   time.Second,
+			max:   time.Second * 30,
+			calls: 10,
+			want:  time.Second * 30,
+		},
+		{
+			name:  "Test_backoff_Next_4",
+			min:   time.Second,
+			max:   time.Second * 30,
+			calls: 10,
+			reset: true,
+			want:  time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBackoff(tt.min, tt.max, DefaultBackoffFactor)
+			var got time.Duration
+			for i := 0; i < tt.calls; i++ {
+				got = b.Next()
+			}
+			if tt.reset {
+				b.Reset()
+				got = b.Next()
+			}
+			if got < tt.want/2 || got > tt.want {
+				t.Errorf("backoff.Next() = %v, want between %v and %v", got, tt.want/2, tt.want)
+			}
+		})
+	}
+}
@@ -2,62 +2,207 @@ package runner
 
 import (
 	"context"
-	"github.com/Shanghai-Lunara/publisher/pkg/scheduler"
+	"errors"
+	"fmt"
 	"github.com/Shanghai-Lunara/publisher/pkg/types"
 	"github.com/gorilla/websocket"
 	"k8s.io/klog/v2"
 	"net/url"
+	"sync"
 	"time"
 )
 
+// ConnectionState was the state of the websocket connection between the Runner and the Scheduler
+type ConnectionState string
+
+const (
+	ConnectionStateConnecting   ConnectionState = "Connecting"
+	ConnectionStateConnected    ConnectionState = "Connected"
+	ConnectionStateDisconnected ConnectionState = "Disconnected"
+)
+
+// ErrConnectionWasGone was the reason of the disconnection which was closed by the Scheduler with the CodeGone,
+// such as the Runner which was replaced by its duplicate. The Client wouldn't redial the Scheduler after it
+var ErrConnectionWasGone = errors.New("error: the connection was closed by the Scheduler on purpose")
+
 type Client struct {
+	mu           sync.RWMutex
+	addr         string
 	conn         *websocket.Conn
 	writeChan    chan []byte
 	runner       *Runner
-	streamOutput chan string
-	pingTimer    bool
-	currentStep  *types.Step
-	ctx          context.Context
-	cancel       context.CancelFunc
+	streamOutput chan *types.LogStreamRequest
+	// spool holds the output lines until they were acknowledged by the Scheduler
+	spool *spool
+	// unsent was the message whose write failed, it would be sent again after the next registration
+	unsent []byte
+	state  ConnectionState
+	// OnStateChange would be called every time when the ConnectionState was changed,
+	// the err was the reason of the disconnection
+	OnStateChange func(state ConnectionState, err error)
+	backoff       *backoff
+	ctx           context.Context
+	cancel        context.CancelFunc
 }
 
-func NewClient(addr string, streamOutput chan string, r *Runner) (*Client, error) {
-	u := url.URL{Scheme: "ws", Host: addr, Path: types.WebsocketHandlerRunner}
-	klog.Info("url:", u)
-	a, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+// NewClient returns a Client which keeps connecting to the Scheduler at the addr.
+// The output lines of the Runner would be spooled into the spoolDir before being sent.
+func NewClient(addr string, streamOutput chan *types.LogStreamRequest, r *Runner, spoolDir string) (*Client, error) {
+	if spoolDir == "" {
+		spoolDir = DefaultSpoolDir
+	}
+	sp, err := newSpool(spoolDir, fmt.Sprintf("%s_%s_%s", r.Namespace, r.GroupName, r.Name))
 	if err != nil {
-		klog.Fatal(err)
+		klog.V(2).Info(err)
 		return nil, err
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	c := &Client{
-		conn:         a,
+		addr:         addr,
 		writeChan:    make(chan []byte, 1024),
 		runner:       r,
 		streamOutput: streamOutput,
-		pingTimer:    false,
+		spool:        sp,
+		state:        ConnectionStateDisconnected,
+		backoff:      newBackoff(DefaultBackoffMin, DefaultBackoffMax, DefaultBackoffFactor),
 		ctx:          ctx,
 		cancel:       cancel,
 	}
 	c.runner.StreamOutput = c.streamOutput
-	go c.readPump()
-	go c.writePump()
 	go c.logStream()
-	c.register()
+	go c.supervise()
 	return c, nil
 }
 
-func (c *Client) register() {
+// State returns the current ConnectionState of the Client
+func (c *Client) State() ConnectionState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.state
+}
+
+func (c *Client) setState(state ConnectionState, err error) {
+	c.mu.Lock()
+	if c.state == state {
+		c.mu.Unlock()
+		return
+	}
+	c.state = state
+	c.mu.Unlock()
+	klog.Infof("Client connection state:%s addr:%s err:%v", state, c.addr, err)
+	if c.OnStateChange != nil {
+		c.OnStateChange(state, err)
+	}
+}
+
+// supervise keeps the connection to the Scheduler alive until the Client was shutdown.
+// It would redial the Scheduler with an exponential backoff after every disconnection except the ErrConnectionWasGone.
+func (c *Client) supervise() {
+	for {
+		c.setState(ConnectionStateConnecting, nil)
+		conn, err := c.dial()
+		if err == nil {
+			c.backoff.Reset()
+			c.setState(ConnectionStateConnected, nil)
+			err = c.serve(conn)
+		}
+		c.setState(ConnectionStateDisconnected, err)
+		if err == ErrConnectionWasGone {
+			return
+		}
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(c.backoff.Next()):
+		}
+	}
+}
+
+func (c *Client) dial() (*websocket.Conn, error) {
+	u := url.URL{Scheme: "ws", Host: c.addr, Path: types.WebsocketHandlerRunner}
+	klog.Info("url:", u)
+	conn, _, err := websocket.DefaultDialer.DialContext(c.ctx, u.String(), nil)
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	return conn, nil
+}
+
+// serve runs the pumps of the connection, and it blocks until the connection was broken
+func (c *Client) serve(conn *websocket.Conn) error {
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+	c.mu.Lock()
+	c.conn = conn
+	c.mu.Unlock()
+	defer func() {
+		if err := conn.Close(); err != nil {
+			klog.V(2).Info(err)
+		}
+	}()
+	// the registration must be the first message of each connection,
+	// because the messages which were buffered in the writeChan depend on it.
+	data, err := c.register()
+	if err != nil {
+		return err
+	}
+	if err = conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	// replays the output lines which were not acknowledged before the disconnection
+	err = c.spool.replay(func(req *types.LogStreamRequest) error {
+		data, err := logStreamMessage(req)
+		if err != nil {
+			return err
+		}
+		return conn.WriteMessage(websocket.BinaryMessage, data)
+	})
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	// the message which failed in the previous connection was written before the ones in the writeChan
+	c.mu.Lock()
+	unsent := c.unsent
+	c.unsent = nil
+	c.mu.Unlock()
+	if unsent != nil {
+		if err = conn.WriteMessage(websocket.BinaryMessage, unsent); err != nil {
+			klog.V(2).Info(err)
+			c.requeue(unsent)
+			return err
+		}
+	}
+	errChan := make(chan error, 2)
+	go func() {
+		errChan <- c.readPump(ctx, conn)
+	}()
+	go func() {
+		errChan <- c.writePump(ctx, conn)
+	}()
+	select {
+	case err = <-errChan:
+	case <-c.ctx.Done():
+	}
+	return err
+}
+
+// register returns the RegisterRunnerRequest which contains the current states of all steps
+func (c *Client) register() ([]byte, error) {
 	ri, err := c.runner.Register()
 	if err != nil {
-		klog.Fatal(err)
+		klog.V(2).Info(err)
+		return nil, err
 	}
 	req1 := &types.RegisterRunnerRequest{
 		RunnerInfo: ri,
 	}
 	data, err := req1.Marshal()
 	if err != nil {
-		klog.Fatal(err)
+		klog.V(2).Info(err)
+		return nil, err
 	}
 	req2 := &types.Request{
 		Type: types.Type{
@@ -66,19 +211,15 @@ func (c *Client) register() {
 		},
 		Data: data,
 	}
-	data2, err := req2.Marshal()
-	if err != nil {
-		klog.Fatal(err)
-	}
-	c.writeChan <- data2
+	return req2.Marshal()
 }
 
-func (c *Client) ping() {
-	tick := time.NewTicker(time.Second * time.Duration(scheduler.WebsocketConnectionTimeout/2))
+func (c *Client) ping(ctx context.Context) {
+	tick := time.NewTicker(time.Second * time.Duration(types.WebsocketConnectionTimeout/2))
 	defer tick.Stop()
 	for {
 		select {
-		case <-c.ctx.Done():
+		case <-ctx.Done():
 			return
 		case <-tick.C:
 			var data []byte
@@ -91,54 +232,86 @@ func (c *Client) ping() {
 			}
 			res, err := req.Marshal()
 			if err != nil {
-				klog.Fatal(err)
-				return
+				klog.V(2).Info(err)
+				continue
 			}
 			c.writeChan <- res
 		}
 	}
 }
 
-func (c *Client) readPump() {
+func (c *Client) readPump(ctx context.Context, conn *websocket.Conn) error {
+	pinging := false
 	for {
-		messageType, message, err := c.conn.ReadMessage()
+		messageType, message, err := conn.ReadMessage()
 		klog.V(5).Infof("messageType: %d message: %s err:%v\n", messageType, string(message), err)
 		if err != nil {
-			klog.Fatal(err)
-			return
+			klog.V(2).Info(err)
+			return err
 		}
-		req := &types.Request{}
+		req := &types.Response{}
 		if err := req.Unmarshal(message); err != nil {
-			klog.Fatal(err)
-			return
+			klog.V(2).Info(err)
+			continue
+		}
+		// the ping ticker belongs to the current connection, it would be started even if the Runner was pending
+		// because its group was not existed, and the Runner would be registered after the group was created
+		if req.Type.ServiceAPI == types.RegisterRunner && !pinging {
+			pinging = true
+			go c.ping(ctx)
+		}
+		if req.Code == types.CodeGone {
+			klog.Errorf("the Scheduler closed serviceApi:%s code:%d message:%s", req.Type.ServiceAPI, req.Code, req.Message)
+			return ErrConnectionWasGone
+		}
+		if req.Code != types.CodeOK {
+			klog.Errorf("the Scheduler failed serviceApi:%s code:%d message:%s", req.Type.ServiceAPI, req.Code, req.Message)
+			continue
 		}
 
 		switch req.Type.ServiceAPI {
 		case types.RegisterRunner:
-			if c.pingTimer == false {
-				go c.ping()
-			}
 		case types.Ping:
+		case types.LogStream:
+			data := &types.LogStreamResponse{}
+			if err = data.Unmarshal(req.Data); err != nil {
+				klog.V(2).Info(err)
+				continue
+			}
+			if err = c.spool.ack(data.Seq); err != nil {
+				klog.V(2).Info(err)
+			}
 		case types.RunStep:
 			data := &types.RunStepRequest{}
 			if err = data.Unmarshal(req.Data); err != nil {
-				klog.Fatal(err)
+				klog.V(2).Info(err)
+				continue
 			}
 			go func() {
-				c.currentStep = &data.Step
-				if err = c.runner.Run(&data.Step); err != nil {
+				if err := c.runner.Run(&data.Step); err != nil {
 					klog.V(2).Info(err)
 					// todo catching error, update Step's Messages, and report to Scheduler
 				}
-				if err = c.updateStepInformationToScheduler(&data.Step); err != nil {
-					klog.Fatal(err)
+				if err := c.updateStepInformationToScheduler(&data.Step); err != nil {
+					klog.V(2).Info(err)
 				}
 			}()
 
+		case types.CancelStep:
+			data := &types.CancelStepRequest{}
+			if err = data.Unmarshal(req.Data); err != nil {
+				klog.V(2).Info(err)
+				continue
+			}
+			// the Step would be reported to the Scheduler after the running returned
+			if err = c.runner.Cancel(data.StepName); err != nil {
+				klog.V(2).Info(err)
+			}
 		case types.UpdateStep:
 			data := &types.UpdateStepRequest{}
 			if err = data.Unmarshal(req.Data); err != nil {
-				klog.Fatal(err)
+				klog.V(2).Info(err)
+				continue
 			}
 			if err = c.runner.Update(&data.Step); err != nil {
 				klog.V(2).Info(err)
@@ -148,65 +321,85 @@ func (c *Client) readPump() {
 	}
 }
 
-func (c *Client) writePump() {
+func (c *Client) writePump(ctx context.Context, conn *websocket.Conn) error {
 	for {
 		select {
 		case msg, isClose := <-c.writeChan:
 			if !isClose {
-				return
+				return nil
 			}
-			if err := c.conn.WriteMessage(websocket.BinaryMessage, msg); err != nil {
-				klog.Fatal(err)
-				return
+			if err := conn.WriteMessage(websocket.BinaryMessage, msg); err != nil {
+				klog.V(2).Info(err)
+				c.requeue(msg)
+				return err
 			}
-		case <-c.ctx.Done():
-			return
+		case <-ctx.Done():
+			return nil
 		}
 	}
 }
 
+// requeue keeps the message whose write failed, so that it would be sent after reconnecting.
+// The output line which had been spooled might be received twice, and the Scheduler would drop the replayed one
+func (c *Client) requeue(msg []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unsent = msg
+}
+
+// logStream spools all the output lines, and sends them to the Scheduler while the Client was connected.
+// The lines which were produced during the disconnection would be replayed after reconnecting.
 func (c *Client) logStream() {
 	for {
 		select {
-		case log, isClose := <-c.streamOutput:
+		case req, isClose := <-c.streamOutput:
 			if !isClose {
 				return
 			}
-			if c.currentStep == nil {
-				klog.V(2).Info("Client currentStep was nil")
-				continue
-			}
-			req1 := &types.LogStreamRequest{
-				Namespace:  c.runner.Namespace,
-				GroupName:  c.runner.GroupName,
-				RunnerName: c.runner.Name,
-				StepName:   c.currentStep.Name,
-				Output:     log,
-			}
-			data, err := req1.Marshal()
-			if err != nil {
-				klog.Fatal(err)
+			if err := c.spool.append(req); err != nil {
+				// the line would be sent without the Seq, and it couldn't be replayed
+				klog.V(2).Info(err)
 			}
-			req2 := &types.Request{
-				Type: types.Type{
-					Body:       types.BodyRunner,
-					ServiceAPI: types.LogStream,
-				},
-				Data: data,
+			if c.State() != ConnectionStateConnected {
+				continue
 			}
-			data, err = req2.Marshal()
+			data, err := logStreamMessage(req)
 			if err != nil {
-				klog.Fatal(err)
+				continue
 			}
 			c.writeChan <- data
+		case <-c.ctx.Done():
+			return
 		}
 	}
 }
 
+func logStreamMessage(req *types.LogStreamRequest) ([]byte, error) {
+	data, err := req.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	req2 := &types.Request{
+		Type: types.Type{
+			Body:       types.BodyRunner,
+			ServiceAPI: types.LogStream,
+		},
+		Data: data,
+	}
+	data, err = req2.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	return data, nil
+}
+
 func (c *Client) updateStepInformationToScheduler(s *types.Step) (err error) {
 	s, err = c.runner.Step(s)
 	if err != nil {
-		klog.Fatal(err)
+		klog.V(2).Info(err)
+		return err
 	}
 	req1 := &types.UpdateStepRequest{
 		Namespace:  c.runner.Namespace,
@@ -216,7 +409,8 @@ func (c *Client) updateStepInformationToScheduler(s *types.Step) (err error) {
 	}
 	data, err := req1.Marshal()
 	if err != nil {
-		klog.Fatal(err)
+		klog.V(2).Info(err)
+		return err
 	}
 	req2 := &types.Request{
 		Type: types.Type{
@@ -227,8 +421,24 @@ func (c *Client) updateStepInformationToScheduler(s *types.Step) (err error) {
 	}
 	data, err = req2.Marshal()
 	if err != nil {
-		klog.Fatal(err)
+		klog.V(2).Info(err)
+		return err
 	}
 	c.writeChan <- data
 	return nil
 }
+
+// Shutdown stops all the goroutines of the Client and closes the websocket connection
+func (c *Client) Shutdown() {
+	c.cancel()
+	if err := c.spool.Close(); err != nil {
+		klog.V(2).Info(err)
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.conn != nil {
+		if err := c.conn.Close(); err != nil {
+			klog.V(2).Info(err)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package runner
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/interfaces"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"github.com/Shanghai-Lunara/publisher/pkg/utils/operators"
+)
+
+const (
+	StepOperatorGit    = "git"
+	StepOperatorSvn    = "svn"
+	StepOperatorFtp    = "ftp"
+	StepOperatorRobot  = "robot"
+	StepOperatorScript = "script"
+)
+
+// the common params which could be set on all kinds of the StepOperators
+const (
+	ParamName      = "name"
+	ParamPolicy    = "policy"
+	ParamAvailable = "available"
+	ParamTimeout   = "timeoutInSec"
+	// the params of the RetryPolicy, the retryOn was the reasons split by the comma such as `error,timeout`
+	ParamRetryMaxAttempts = "retryMaxAttempts"
+	ParamRetryBackoff     = "retryBackoffInSec"
+	ParamRetryMaxBackoff  = "retryMaxBackoffInSec"
+	ParamRetryOn          = "retryOn"
+	// the names of the resource locks split by the comma, and the StepLockPolicy when they were held by the others
+	ParamLocks      = "locks"
+	ParamLockPolicy = "lockPolicy"
+)
+
+const (
+	ErrStepOperatorTypeWasNotSupported = "error: StepOperator type:%s was not supported"
+	ErrStepOperatorParamWasRequired    = "error: StepOperator type:%s param:%s was required"
+	ErrStepOperatorParamWasInvalid     = "error: StepOperator type:%s param:%s value:%s was invalid"
+	ErrStepOperatorNameWasDuplicated   = "error: StepOperator name:%s was duplicated"
+)
+
+// NewStepOperators builds all the interfaces.StepOperator in the same order as the configuration declared.
+func NewStepOperators(items []conf.StepOperator) ([]interfaces.StepOperator, error) {
+	res := make([]interfaces.StepOperator, 0)
+	names := make(map[string]bool, 0)
+	for _, v := range items {
+		op, err := newStepOperator(v)
+		if err != nil {
+			return nil, err
+		}
+		if name, ok := v.Params[ParamName]; ok && name != "" {
+			op.Step().Name = name
+		}
+		if policy, ok := v.Params[ParamPolicy]; ok && policy != "" {
+			op.Step().Policy = types.StepPolicy(policy)
+		}
+		if available, ok := v.Params[ParamAvailable]; ok && available != "" {
+			op.Step().Available = types.StepAvailable(available)
+		}
+		if _, ok := v.Params[ParamTimeout]; ok {
+			p := &params{kind: v.Type, items: v.Params}
+			timeout, err := p.int(ParamTimeout)
+			if err != nil {
+				return nil, err
+			}
+			op.Step().TimeoutInSec = int32(timeout)
+		}
+		if err = setRetryPolicy(&op.Step().Retry, &params{kind: v.Type, items: v.Params}); err != nil {
+			return nil, err
+		}
+		if err = setLocks(op.Step(), &params{kind: v.Type, items: v.Params}); err != nil {
+			return nil, err
+		}
+		if names[op.Step().Name] {
+			return nil, fmt.Errorf(ErrStepOperatorNameWasDuplicated, op.Step().Name)
+		}
+		names[op.Step().Name] = true
+		res = append(res, op)
+	}
+	return res, nil
+}
+
+// setRetryPolicy sets the RetryPolicy by the params, the ones which weren't declared would be kept
+func setRetryPolicy(r *types.RetryPolicy, p *params) error {
+	for _, v := range []struct {
+		key   string
+		value *int32
+	}{
+		{ParamRetryMaxAttempts, &r.MaxAttempts},
+		{ParamRetryBackoff, &r.BackoffInSec},
+		{ParamRetryMaxBackoff, &r.MaxBackoffInSec},
+	} {
+		if _, ok := p.items[v.key]; !ok {
+			continue
+		}
+		n, err := p.int(v.key)
+		if err != nil {
+			return err
+		}
+		*v.value = int32(n)
+	}
+	if retryOn, ok := p.items[ParamRetryOn]; ok {
+		r.RetryOn = make([]string, 0)
+		for _, v := range strings.Split(retryOn, ",") {
+			switch v = strings.TrimSpace(v); v {
+			case "":
+			case types.StepReasonError, types.StepReasonTimeout:
+				r.RetryOn = append(r.RetryOn, v)
+			default:
+				return fmt.Errorf(ErrStepOperatorParamWasInvalid, p.kind, ParamRetryOn, retryOn)
+			}
+		}
+	}
+	return nil
+}
+
+// setLocks sets the resource locks and the StepLockPolicy of the Step by the params
+func setLocks(step *types.Step, p *params) error {
+	if locks, ok := p.items[ParamLocks]; ok {
+		step.Locks = make([]string, 0)
+		seen := make(map[string]bool, 0)
+		for _, v := range strings.Split(locks, ",") {
+			if v = strings.TrimSpace(v); v != "" && !seen[v] {
+				seen[v] = true
+				step.Locks = append(step.Locks, v)
+			}
+		}
+	}
+	if policy, ok := p.items[ParamLockPolicy]; ok {
+		switch types.StepLockPolicy(policy) {
+		case types.StepLockPolicyQueue, types.StepLockPolicyReject:
+			step.LockPolicy = types.StepLockPolicy(policy)
+		default:
+			return fmt.Errorf(ErrStepOperatorParamWasInvalid, p.kind, ParamLockPolicy, policy)
+		}
+	}
+	return nil
+}
+
+func newStepOperator(c conf.StepOperator) (interfaces.StepOperator, error) {
+	p := &params{kind: c.Type, items: c.Params}
+	switch c.Type {
+	case StepOperatorGit:
+		dir, err := p.string("dir")
+		if err != nil {
+			return nil, err
+		}
+		branch, err := p.string("branch")
+		if err != nil {
+			return nil, err
+		}
+		return operators.NewGit(dir, branch), nil
+	case StepOperatorSvn:
+		host, err := p.string("host")
+		if err != nil {
+			return nil, err
+		}
+		port, err := p.int("port")
+		if err != nil {
+			return nil, err
+		}
+		remoteDir, err := p.string("remoteDir")
+		if err != nil {
+			return nil, err
+		}
+		workDir, err := p.string("workDir")
+		if err != nil {
+			return nil, err
+		}
+		return operators.NewSvn(host, port, p.items["username"], p.items["password"], remoteDir, workDir), nil
+	case StepOperatorFtp:
+		host, err := p.string("host")
+		if err != nil {
+			return nil, err
+		}
+		port, err := p.int("port")
+		if err != nil {
+			return nil, err
+		}
+		workDir, err := p.string("workDir")
+		if err != nil {
+			return nil, err
+		}
+		timeout, err := p.int("timeout")
+		if err != nil {
+			return nil, err
+		}
+		f := operators.NewFtp(host, port, p.items["username"], p.items["password"], workDir, timeout)
+		f.SettingPrepareFunc(func() {})
+		return f, nil
+	case StepOperatorRobot:
+		duration, err := p.int("durationInMs")
+		if err != nil {
+			return nil, err
+		}
+		r := operators.NewRobot(types.StepPolicyManual, int64(duration))
+		r.SettingPrepareFunc(func() {})
+		return r, nil
+	case StepOperatorScript:
+		name, err := p.string(ParamName)
+		if err != nil {
+			return nil, err
+		}
+		commands, file := p.items["command"], p.items["file"]
+		if commands == "" && file == "" {
+			return nil, fmt.Errorf(ErrStepOperatorParamWasRequired, c.Type, "command")
+		}
+		allowList := make([]string, 0)
+		for _, v := range strings.Split(p.items["envAllowList"], ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				allowList = append(allowList, v)
+			}
+		}
+		return operators.NewScript(name, commands, file, p.items["workDir"], allowList), nil
+	}
+	return nil, fmt.Errorf(ErrStepOperatorTypeWasNotSupported, c.Type)
+}
+
+type params struct {
+	kind  string
+	items map[string]string
+}
+
+func (p *params) string(key string) (string, error) {
+	v, ok := p.items[key]
+	if !ok || v == "" {
+		return "", fmt.Errorf(ErrStepOperatorParamWasRequired, p.kind, key)
+	}
+	return v, nil
+}
+
+func (p *params) int(key string) (int, error) {
+	v, err := p.string(key)
+	if err != nil {
+		return 0, err
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf(ErrStepOperatorParamWasInvalid, p.kind, key, v)
+	}
+	return i, nil
+}
@@ -0,0 +1,78 @@
+package runner
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+)
+
+func TestNewStepOperators(t *testing.T) {
+	tests := []struct {
+		name      string
+		items     []conf.StepOperator
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name: "TestNewStepOperators_1",
+			items: []conf.StepOperator{
+				{Type: StepOperatorGit, Params: map[string]string{"dir": "/data/git", "branch": "main"}},
+				{Type: StepOperatorSvn, Params: map[string]string{"host": "127.0.0.1", "port": "3690", "remoteDir": "client", "workDir": "/data/svn"}},
+				{Type: StepOperatorFtp, Params: map[string]string{"host": "127.0.0.1", "port": "21", "workDir": "/upload", "timeout": "10"}},
+				{Type: StepOperatorRobot, Params: map[string]string{"durationInMs": "100"}},
+				{Type: StepOperatorScript, Params: map[string]string{"name": "Export-Data", "command": "./export.sh"}},
+			},
+			wantNames: []string{"Git-Operator", "SVN-Operator", "Ftp-Operator", "Robot", "Export-Data"},
+		},
+		{
+			name: "TestNewStepOperators_2",
+			items: []conf.StepOperator{
+				{Type: StepOperatorRobot, Params: map[string]string{"name": "Robot-1", "durationInMs": "100"}},
+				{Type: StepOperatorRobot, Params: map[string]string{"name": "Robot-2", "durationInMs": "100"}},
+			},
+			wantNames: []string{"Robot-1", "Robot-2"},
+		},
+		{
+			name: "TestNewStepOperators_3",
+			items: []conf.StepOperator{
+				{Type: "docker", Params: map[string]string{"name": "Build"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestNewStepOperators_4",
+			items: []conf.StepOperator{
+				{Type: StepOperatorSvn, Params: map[string]string{"host": "127.0.0.1", "port": "svn", "remoteDir": "client", "workDir": "/data/svn"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestNewStepOperators_5",
+			items: []conf.StepOperator{
+				{Type: StepOperatorRobot, Params: map[string]string{"durationInMs": "100"}},
+				{Type: StepOperatorRobot, Params: map[string]string{"durationInMs": "200"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewStepOperators(tt.items)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewStepOperators() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			names := make([]string, 0, len(got))
+			for _, v := range got {
+				names = append(names, v.Step().Name)
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("NewStepOperators() names = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
@@ -1,15 +1,18 @@
 package runner
 
 import (
+	"context"
 	"fmt"
 	"github.com/Shanghai-Lunara/publisher/pkg/interfaces"
 	"github.com/Shanghai-Lunara/publisher/pkg/types"
 	"k8s.io/klog/v2"
+	"sync"
 	"time"
 )
 
 const (
 	StepOperatorWasNotExisted = "err: the specific interfaces.StepOperator step-name:%s was not existed"
+	StepWasNotRunning         = "err: the specific step-name:%s was not running"
 )
 
 type Runner struct {
@@ -18,8 +21,14 @@ type Runner struct {
 	Namespace     types.Namespace           `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
 	GroupName     types.GroupName           `json:"groupName" protobuf:"bytes,4,opt,name=groupName"`
 	StepOperators []interfaces.StepOperator `json:"stepOperators" protobuf:"bytes,5,opt,name=stepOperators"`
-	// StreamOutput was a chan<- string which was used to transfer exec outputs by the stream.
-	StreamOutput chan<- string `json:"streamOutput"`
+	Labels        map[string]string         `json:"labels" protobuf:"bytes,6,opt,name=labels"`
+	// StreamOutput was a chan<- *types.LogStreamRequest which was used to transfer exec outputs by the stream.
+	// Each line was tagged with the name of the running step which produced it.
+	StreamOutput chan<- *types.LogStreamRequest `json:"streamOutput"`
+
+	mu sync.Mutex
+	// cancels were the cancel functions of the running steps' contexts
+	cancels map[string]context.CancelFunc
 }
 
 func (r *Runner) Register() (res types.RunnerInfo, err error) {
@@ -34,6 +43,7 @@ func (r *Runner) Register() (res types.RunnerInfo, err error) {
 		GroupName:  r.GroupName,
 		RunnerType: types.RunnerTypeServer,
 		Steps:      steps,
+		Labels:     r.Labels,
 	}
 	return res, nil
 }
@@ -44,17 +54,36 @@ func (r *Runner) Run(s *types.Step) (err error) {
 		if v.Step().Name == s.Name {
 			v.Update(s)
 			exist = true
+			ctx := r.startStep(s.Name, s.Timeout())
+			defer r.stopStep(s.Name)
 			start := time.Now()
 			s.DurationInMS = 0
 			v.Prepare()
-			res, err := v.Run(r.StreamOutput)
+			output, done := r.stepOutput(s.Name)
+			res, err := v.Run(ctx, output)
 			if err != nil {
 				klog.V(2).Info(err)
-				r.StreamOutput <- err.Error()
+				output <- err.Error()
+			}
+			close(output)
+			<-done
+			if err != nil {
 				if len(v.Step().Messages) == 0 {
 					v.Step().Messages = make([]string, 0)
 				}
-				v.Step().Messages = append(v.Step().Messages, err.Error())
+				switch ctx.Err() {
+				case context.Canceled:
+					v.Step().Phase = types.StepFailed
+					v.Step().Reason = types.StepReasonCancelled
+					v.Step().Messages = append(v.Step().Messages, types.StepTerminatedMessage(s.Name, types.StepReasonCancelled))
+				case context.DeadlineExceeded:
+					v.Step().Phase = types.StepFailed
+					v.Step().Reason = types.StepReasonTimeout
+					v.Step().Messages = append(v.Step().Messages, types.StepTerminatedMessage(s.Name, types.StepReasonTimeout))
+				default:
+					v.Step().Reason = types.StepReasonError
+					v.Step().Messages = append(v.Step().Messages, err.Error())
+				}
 				return err
 			}
 			v.Step().DurationInMS = int32(time.Now().Sub(start).Milliseconds())
@@ -68,6 +97,68 @@ func (r *Runner) Run(s *types.Step) (err error) {
 	return nil
 }
 
+// stepOutput returns the output chan of the step, the lines would be tagged with the step name and sent to
+// the StreamOutput until the output was closed. The done would be closed after all the lines were sent.
+func (r *Runner) stepOutput(stepName string) (output chan string, done chan struct{}) {
+	output = make(chan string, 1024)
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		for line := range output {
+			if r.StreamOutput == nil {
+				continue
+			}
+			r.StreamOutput <- &types.LogStreamRequest{
+				Namespace:  r.Namespace,
+				GroupName:  r.GroupName,
+				RunnerName: r.Name,
+				StepName:   stepName,
+				Output:     line,
+			}
+		}
+	}()
+	return output, done
+}
+
+// Cancel kills the running commands of the specific step
+func (r *Runner) Cancel(stepName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cancel, ok := r.cancels[stepName]
+	if !ok {
+		return fmt.Errorf(StepWasNotRunning, stepName)
+	}
+	cancel()
+	return nil
+}
+
+// startStep returns the context of the running step, the context would be done after the timeout if it was not zero
+func (r *Runner) startStep(stepName string, timeout time.Duration) context.Context {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancels == nil {
+		r.cancels = make(map[string]context.CancelFunc, 0)
+	}
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	r.cancels[stepName] = cancel
+	return ctx
+}
+
+func (r *Runner) stopStep(stepName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cancel, ok := r.cancels[stepName]; ok {
+		cancel()
+		delete(r.cancels, stepName)
+	}
+}
+
 func (r *Runner) Update(s *types.Step) (err error) {
 	exist := false
 	for _, v := range r.StepOperators {
@@ -0,0 +1,211 @@
+package runner
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultSpoolDir was the directory of the spool files when it wasn't configured
+	DefaultSpoolDir = "spool"
+	// SpoolCompactSize was the size of the spool file which would be truncated after all the lines were acknowledged
+	SpoolCompactSize = 4 << 20
+	// spoolAckFlushInterval limits how often the acknowledged sequence number would be persisted
+	spoolAckFlushInterval = time.Second
+)
+
+const (
+	ErrSpoolRecordWasBroken = "error: spool file:%s record at offset:%d was broken"
+)
+
+// spool was an append-only file which holds all the LogStreamRequest lines of the Runner.
+// Each record was a 4 bytes big-endian length followed by the marshaled LogStreamRequest.
+// The lines whose Seq were greater than the acked would be replayed after the Client reconnected,
+// and the file would be truncated once all the lines were acknowledged by the Scheduler.
+type spool struct {
+	mu        sync.Mutex
+	path      string
+	file      *os.File
+	size      int64
+	seq       int64
+	acked     int64
+	flushedAt time.Time
+}
+
+func newSpool(dir, name string) (*spool, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	sp := &spool{
+		path: filepath.Join(dir, name+".log"),
+	}
+	if data, err := ioutil.ReadFile(sp.ackPath()); err == nil {
+		if sp.acked, err = strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err != nil {
+			klog.V(2).Info(err)
+			return nil, err
+		}
+	}
+	sp.seq = sp.acked
+	// recover the sequence number from the lines which haven't been acknowledged
+	size, err := sp.scan(func(req *types.LogStreamRequest) error {
+		if req.Seq > sp.seq {
+			sp.seq = req.Seq
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(sp.path, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	// drops the broken tail which was left by a crash in the middle of a write
+	if err = f.Truncate(size); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	if _, err = f.Seek(size, io.SeekStart); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	sp.file = f
+	sp.size = size
+	return sp, nil
+}
+
+func (sp *spool) ackPath() string {
+	return sp.path + ".ack"
+}
+
+// append assigns the next Seq to the req, and writes it into the spool file.
+// The Seq of the req was reset if it was not spooled, so that the next line wouldn't reuse the Seq
+func (sp *spool) append(req *types.LogStreamRequest) error {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	req.Seq = sp.seq + 1
+	data, err := req.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		req.Seq = 0
+		return err
+	}
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	if _, err = sp.file.Write(buf); err != nil {
+		klog.V(2).Info(err)
+		req.Seq = 0
+		return err
+	}
+	sp.seq = req.Seq
+	sp.size += int64(len(buf))
+	return nil
+}
+
+// ack marks all the lines whose Seq were not greater than the seq as received by the Scheduler
+func (sp *spool) ack(seq int64) error {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if seq <= sp.acked || seq > sp.seq {
+		return nil
+	}
+	sp.acked = seq
+	if sp.acked == sp.seq && sp.size >= SpoolCompactSize {
+		if err := sp.file.Truncate(0); err != nil {
+			klog.V(2).Info(err)
+			return err
+		}
+		if _, err := sp.file.Seek(0, io.SeekStart); err != nil {
+			klog.V(2).Info(err)
+			return err
+		}
+		sp.size = 0
+		return sp.flush()
+	}
+	if time.Since(sp.flushedAt) < spoolAckFlushInterval {
+		return nil
+	}
+	return sp.flush()
+}
+
+// flush persists the acked sequence number, the caller must hold the lock
+func (sp *spool) flush() error {
+	sp.flushedAt = time.Now()
+	if err := ioutil.WriteFile(sp.ackPath(), []byte(strconv.FormatInt(sp.acked, 10)), 0644); err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	return nil
+}
+
+// replay calls the f with all the lines which haven't been acknowledged in order
+func (sp *spool) replay(f func(req *types.LogStreamRequest) error) error {
+	sp.mu.Lock()
+	acked := sp.acked
+	sp.mu.Unlock()
+	_, err := sp.scan(func(req *types.LogStreamRequest) error {
+		if req.Seq <= acked {
+			return nil
+		}
+		return f(req)
+	})
+	return err
+}
+
+// scan reads all the complete records of the spool file, and returns the size of them
+func (sp *spool) scan(f func(req *types.LogStreamRequest) error) (size int64, err error) {
+	file, err := os.Open(sp.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		klog.V(2).Info(err)
+		return 0, err
+	}
+	defer file.Close()
+	r := bufio.NewReader(file)
+	head := make([]byte, 4)
+	for {
+		if _, err = io.ReadFull(r, head); err != nil {
+			break
+		}
+		data := make([]byte, binary.BigEndian.Uint32(head))
+		if _, err = io.ReadFull(r, data); err != nil {
+			break
+		}
+		req := &types.LogStreamRequest{}
+		if err = req.Unmarshal(data); err != nil {
+			klog.V(2).Info(fmt.Errorf(ErrSpoolRecordWasBroken, sp.path, size))
+			break
+		}
+		if err = f(req); err != nil {
+			return size, err
+		}
+		size += int64(len(head) + len(data))
+	}
+	return size, nil
+}
+
+func (sp *spool) Close() error {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if err := sp.flush(); err != nil {
+		return err
+	}
+	return sp.file.Close()
+}
@@ -0,0 +1,134 @@
+package runner
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func Test_spool_replay(t *testing.T) {
+	tests := []struct {
+		name    string
+		lines   []string
+		ack     int64
+		reopen  bool
+		wantSeq []int64
+	}{
+		{
+			name:    "Test_spool_replay_1",
+			lines:   []string{"a", "b", "c"},
+			ack:     0,
+			wantSeq: []int64{1, 2, 3},
+		},
+		{
+			name:    "Test_spool_replay_2",
+			lines:   []string{"a", "b", "c"},
+			ack:     2,
+			wantSeq: []int64{3},
+		},
+		{
+			name:    "Test_spool_replay_3",
+			lines:   []string{"a", "b", "c"},
+			ack:     1,
+			reopen:  true,
+			wantSeq: []int64{2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "spool")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			sp, err := newSpool(dir, "runner")
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, v := range tt.lines {
+				if err = sp.append(&types.LogStreamRequest{StepName: "step", Output: v}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err = sp.ack(tt.ack); err != nil {
+				t.Fatal(err)
+			}
+			if tt.reopen {
+				if err = sp.Close(); err != nil {
+					t.Fatal(err)
+				}
+				if sp, err = newSpool(dir, "runner"); err != nil {
+					t.Fatal(err)
+				}
+				if sp.seq != int64(len(tt.lines)) {
+					t.Errorf("newSpool() seq = %v, want %v", sp.seq, len(tt.lines))
+				}
+			}
+			defer sp.Close()
+			got := make([]int64, 0)
+			err = sp.replay(func(req *types.LogStreamRequest) error {
+				got = append(got, req.Seq)
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.wantSeq) {
+				t.Errorf("spool.replay() = %v, want %v", got, tt.wantSeq)
+			}
+		})
+	}
+}
+
+func Test_spool_append(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sp, err := newSpool(dir, "runner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sp.Close()
+	if err = sp.append(&types.LogStreamRequest{StepName: "step", Output: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	// the spool file which was opened as read-only fails the write
+	f, err := os.Open(sp.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := sp.file
+	sp.file = f
+	req := &types.LogStreamRequest{StepName: "step", Output: "b"}
+	if err = sp.append(req); err == nil {
+		t.Fatalf("spool.append() error = nil, want the failed write")
+	}
+	f.Close()
+	sp.file = file
+	if req.Seq != 0 {
+		t.Errorf("spool.append() Seq = %v of the failed line, want 0", req.Seq)
+	}
+	req = &types.LogStreamRequest{StepName: "step", Output: "c"}
+	if err = sp.append(req); err != nil {
+		t.Fatal(err)
+	}
+	if req.Seq != 2 {
+		t.Errorf("spool.append() Seq = %v, want 2", req.Seq)
+	}
+	got := make([]int64, 0)
+	err = sp.replay(func(req *types.LogStreamRequest) error {
+		got = append(got, req.Seq)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, []int64{1, 2}) {
+		t.Errorf("spool.replay() = %v, want %v", got, []int64{1, 2})
+	}
+}
@@ -0,0 +1,189 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ErrApprovalsWereInvalid       = "error: step:%s approvals:%d was invalid, it must be greater than 0"
+	ErrApproverWasForbidden       = "error: the token wasn't allowed to approve the steps of namespace:%s"
+	ErrStepWasAwaitingApproval    = "error: namespace:%s groupName:%s runner:%s step:%s was awaiting approval"
+	ErrStepWasNotAwaitingApproval = "error: namespace:%s groupName:%s runner:%s step:%s was not awaiting approval"
+	ErrStepWasApprovedByUser      = "error: namespace:%s groupName:%s runner:%s step:%s was approved by user:%s already"
+)
+
+// gate was the RunStepRequest of the gated Step which was awaiting the approvals
+type gate struct {
+	req       *types.RunStepRequest
+	required  int32
+	approvals []types.Approval
+}
+
+func gateKey(runnerName, stepName string) string {
+	return fmt.Sprintf("%s/%s", runnerName, stepName)
+}
+
+// newApprovals validates the required numbers of the approvals by the step names
+func newApprovals(items map[string]int) (map[string]int32, error) {
+	res := make(map[string]int32, 0)
+	for k, v := range items {
+		if v < 1 {
+			return nil, newError(types.CodeInvalid, ErrApprovalsWereInvalid, k, v)
+		}
+		res[k] = int32(v)
+	}
+	return res, nil
+}
+
+// approver returns the user of the token who could approve the steps of the namespace
+func (s *Scheduler) approver(token string, namespace types.Namespace) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range s.approvers {
+		if token == "" || v.Token != token {
+			continue
+		}
+		for _, ns := range v.Namespaces {
+			if ns == PermissionAllNamespaces || ns == string(namespace) {
+				return v.User, nil
+			}
+		}
+	}
+	return "", newError(types.CodeForbidden, ErrApproverWasForbidden, namespace)
+}
+
+// awaitApproval holds the request of the gated Step until it was approved, the caller must hold the s.mu
+func (s *Scheduler) awaitApproval(g *Group, ri *types.RunnerInfo, req *types.RunStepRequest, required int32, o origin) (res []byte, err error) {
+	key := gateKey(req.RunnerName, req.Step.Name)
+	if _, ok := g.gates[key]; ok {
+		return nil, newError(types.CodeBusy, ErrStepWasAwaitingApproval, req.Namespace, req.GroupName, req.RunnerName, req.Step.Name)
+	}
+	req.Step.Approvals = nil
+	g.gates[key] = &gate{
+		req:      req.DeepCopy(),
+		required: required,
+	}
+	for i, v := range ri.Steps {
+		if v.Name != req.Step.Name {
+			continue
+		}
+		v.Phase = types.StepAwaitingApproval
+		v.Approvals = nil
+		ri.Steps[i] = v
+		if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, v.DeepCopy(), o); err != nil {
+			klog.V(2).Info(err)
+		}
+	}
+	s.persistRunner(ri)
+	klog.Infof("await approval step:%s runner:%s namespace:%s groupName:%s required:%d", req.Step.Name, req.RunnerName, req.Namespace, req.GroupName, required)
+	result := &types.RunStepResponse{
+		AwaitingApproval: true,
+	}
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleApproveStep(data []byte, token string, o origin) (res []byte, err error) {
+	req := &types.ApproveStepRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	user, err := s.approver(token, req.Namespace)
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	var g *Group
+	if g, err = s.getGroup(req.Namespace, req.GroupName); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	key := gateKey(req.RunnerName, req.StepName)
+	t, ok := g.gates[key]
+	if !ok {
+		s.mu.Unlock()
+		return nil, newError(types.CodeNotFound, ErrStepWasNotAwaitingApproval, req.Namespace, req.GroupName, req.RunnerName, req.StepName)
+	}
+	for _, v := range t.approvals {
+		if v.User == user {
+			s.mu.Unlock()
+			return nil, newError(types.CodeBusy, ErrStepWasApprovedByUser, req.Namespace, req.GroupName, req.RunnerName, req.StepName, user)
+		}
+	}
+	t.approvals = append(t.approvals, types.Approval{
+		User:       user,
+		ApprovedTM: time.Now().Unix(),
+	})
+	approved := int32(len(t.approvals)) >= t.required
+	if approved {
+		delete(g.gates, key)
+	}
+	klog.Infof("approve step:%s runner:%s namespace:%s groupName:%s user:%s approvals:%d/%d",
+		req.StepName, req.RunnerName, req.Namespace, req.GroupName, user, len(t.approvals), t.required)
+	// the approvals would be synced to all dashboards with the Step
+	if ri, ok := g.Runners[req.RunnerName]; ok {
+		for i, v := range ri.Steps {
+			if v.Name != req.StepName {
+				continue
+			}
+			v.Approvals = append([]types.Approval{}, t.approvals...)
+			if approved {
+				v.Phase = types.StepPending
+			}
+			ri.Steps[i] = v
+			if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, v.DeepCopy(), o); err != nil {
+				klog.V(2).Info(err)
+			}
+		}
+		s.persistRunner(ri)
+	}
+	s.mu.Unlock()
+	result := &types.ApproveStepResponse{
+		Approvals: append([]types.Approval{}, t.approvals...),
+		Required:  t.required,
+	}
+	if approved {
+		// the approvals would be sent to the Runner with the Step, and be recorded after it was run
+		t.req.Step.Approvals = result.Approvals
+		if _, err = s.runStep(t.req, o, true, true); err != nil {
+			klog.V(2).Info(err)
+			return nil, err
+		}
+	}
+	return result.Marshal()
+}
+
+// cancelApproval reports whether the Step was awaiting the approvals, the awaiting one would be terminated as StepFailed
+func (s *Scheduler) cancelApproval(g *Group, ri *types.RunnerInfo, stepName string) bool {
+	s.mu.Lock()
+	key := gateKey(ri.Name, stepName)
+	if _, ok := g.gates[key]; !ok {
+		s.mu.Unlock()
+		return false
+	}
+	delete(g.gates, key)
+	for i, v := range ri.Steps {
+		if v.Name != stepName {
+			continue
+		}
+		v.Phase = types.StepFailed
+		v.Reason = types.StepReasonCancelled
+		v.Messages = append(v.Messages, types.StepTerminatedMessage(stepName, types.StepReasonCancelled))
+		ri.Steps[i] = v
+		if err := s.updateStepToDashboard(ri.Namespace, ri.GroupName, ri.Name, v.DeepCopy(), origin{}); err != nil {
+			klog.V(2).Info(err)
+		}
+	}
+	s.persistRunner(ri)
+	s.mu.Unlock()
+	klog.Infof("cancel the approval of step:%s runner:%s namespace:%s groupName:%s", stepName, ri.Name, ri.Namespace, ri.GroupName)
+	// the Runner wasn't kept busy for the awaiting Step, so it wouldn't be released
+	go s.completeSchedules(ri.Namespace, ri.GroupName, ri.Name, stepName, types.StepFailed)
+	go s.advancePipeline(g, ri.Name, stepName, types.StepFailed)
+	return true
+}
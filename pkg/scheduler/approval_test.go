@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestScheduler_approver(t *testing.T) {
+	approvers := []conf.Approver{
+		{
+			User:       "alice",
+			Token:      "alice-token",
+			Namespaces: []string{PermissionAllNamespaces},
+		},
+		{
+			User:       "bob",
+			Token:      "bob-token",
+			Namespaces: []string{"release"},
+		},
+	}
+	tests := []struct {
+		name      string
+		token     string
+		namespace types.Namespace
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "TestScheduler_approver_1",
+			token:     "alice-token",
+			namespace: "dev",
+			want:      "alice",
+		},
+		{
+			name:      "TestScheduler_approver_2",
+			token:     "bob-token",
+			namespace: "release",
+			want:      "bob",
+		},
+		{
+			name:      "TestScheduler_approver_3",
+			token:     "bob-token",
+			namespace: "dev",
+			wantErr:   true,
+		},
+		{
+			name:      "TestScheduler_approver_4",
+			token:     "",
+			namespace: "release",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				approvers: approvers,
+			}
+			got, err := s.approver(tt.token, tt.namespace)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("approver() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("approver() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
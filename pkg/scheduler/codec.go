@@ -0,0 +1,314 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"github.com/gorilla/websocket"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ErrEncodingWasInvalid       = "error: encoding:%s was invalid"
+	ErrServiceAPIWasNotEncoding = "error: serviceApi:%s couldn't be encoded by json"
+)
+
+// serviceMessages were the messages which were carried by the Data of the ServiceAPI
+type serviceMessages struct {
+	// request was the Data of the Request, and of the broadcast Response
+	request func() apiMessage
+	// response was the Data of the reply Response
+	response func() apiMessage
+}
+
+var serviceAPIMessages = map[types.ServiceAPI]serviceMessages{
+	types.Ping: {
+		func() apiMessage { return &types.PingRequest{} },
+		func() apiMessage { return &types.PongResponse{} },
+	},
+	types.ListNamespace: {
+		func() apiMessage { return &types.ListNamespaceRequest{} },
+		func() apiMessage { return &types.ListNamespaceResponse{} },
+	},
+	types.ListGroupName: {
+		func() apiMessage { return &types.ListGroupNameRequest{} },
+		func() apiMessage { return &types.ListGroupNameResponse{} },
+	},
+	types.ListRunner: {
+		func() apiMessage { return &types.ListRunnerRequest{} },
+		func() apiMessage { return &types.ListRunnerResponse{} },
+	},
+	types.RegisterRunner: {
+		func() apiMessage { return &types.RegisterRunnerRequest{} },
+		func() apiMessage { return &types.RegisterRunnerResponse{} },
+	},
+	types.UpdateStep: {
+		func() apiMessage { return &types.UpdateStepRequest{} },
+		func() apiMessage { return &types.UpdateStepResponse{} },
+	},
+	types.RunStep: {
+		func() apiMessage { return &types.RunStepRequest{} },
+		func() apiMessage { return &types.RunStepResponse{} },
+	},
+	types.LogStream: {
+		func() apiMessage { return &types.LogStreamRequest{} },
+		func() apiMessage { return &types.LogStreamResponse{} },
+	},
+	types.CompleteStep: {
+		func() apiMessage { return &types.CompleteStepRequest{} },
+		func() apiMessage { return &types.CompleteStepResponse{} },
+	},
+	types.CancelStep: {
+		func() apiMessage { return &types.CancelStepRequest{} },
+		func() apiMessage { return &types.CancelStepResponse{} },
+	},
+	types.RunPipeline: {
+		func() apiMessage { return &types.RunPipelineRequest{} },
+		func() apiMessage { return &types.RunPipelineResponse{} },
+	},
+	types.GetPipeline: {
+		func() apiMessage { return &types.GetPipelineRequest{} },
+		func() apiMessage { return &types.GetPipelineResponse{} },
+	},
+	types.PipelineProgress: {
+		func() apiMessage { return &types.Pipeline{} },
+		func() apiMessage { return &types.Pipeline{} },
+	},
+	types.ListSchedules: {
+		func() apiMessage { return &types.ListSchedulesRequest{} },
+		func() apiMessage { return &types.ListSchedulesResponse{} },
+	},
+	types.CreateSchedule: {
+		func() apiMessage { return &types.CreateScheduleRequest{} },
+		func() apiMessage { return &types.CreateScheduleResponse{} },
+	},
+	types.PauseSchedule: {
+		func() apiMessage { return &types.PauseScheduleRequest{} },
+		func() apiMessage { return &types.PauseScheduleResponse{} },
+	},
+	types.DeleteSchedule: {
+		func() apiMessage { return &types.DeleteScheduleRequest{} },
+		func() apiMessage { return &types.DeleteScheduleResponse{} },
+	},
+	types.CreateNamespace: {
+		func() apiMessage { return &types.CreateNamespaceRequest{} },
+		func() apiMessage { return &types.CreateNamespaceResponse{} },
+	},
+	types.RenameNamespace: {
+		func() apiMessage { return &types.RenameNamespaceRequest{} },
+		func() apiMessage { return &types.RenameNamespaceResponse{} },
+	},
+	types.DeleteNamespace: {
+		func() apiMessage { return &types.DeleteNamespaceRequest{} },
+		func() apiMessage { return &types.DeleteNamespaceResponse{} },
+	},
+	types.CreateGroup: {
+		func() apiMessage { return &types.CreateGroupRequest{} },
+		func() apiMessage { return &types.CreateGroupResponse{} },
+	},
+	types.RenameGroup: {
+		func() apiMessage { return &types.RenameGroupRequest{} },
+		func() apiMessage { return &types.RenameGroupResponse{} },
+	},
+	types.DeleteGroup: {
+		func() apiMessage { return &types.DeleteGroupRequest{} },
+		func() apiMessage { return &types.DeleteGroupResponse{} },
+	},
+	types.ListPendingRunners: {
+		func() apiMessage { return &types.ListPendingRunnersRequest{} },
+		func() apiMessage { return &types.ListPendingRunnersResponse{} },
+	},
+	types.DuplicateRunner: {
+		func() apiMessage { return &types.DuplicateRunnerEvent{} },
+		nil,
+	},
+	types.RunnerQueue: {
+		func() apiMessage { return &types.RunnerQueueEvent{} },
+		nil,
+	},
+	types.ListRunnerQueue: {
+		func() apiMessage { return &types.ListRunnerQueueRequest{} },
+		func() apiMessage { return &types.ListRunnerQueueResponse{} },
+	},
+	types.CancelQueuedStep: {
+		func() apiMessage { return &types.CancelQueuedStepRequest{} },
+		func() apiMessage { return &types.CancelQueuedStepResponse{} },
+	},
+	types.MoveQueuedStep: {
+		func() apiMessage { return &types.MoveQueuedStepRequest{} },
+		func() apiMessage { return &types.MoveQueuedStepResponse{} },
+	},
+	types.ApproveStep: {
+		func() apiMessage { return &types.ApproveStepRequest{} },
+		func() apiMessage { return &types.ApproveStepResponse{} },
+	},
+	types.ListLocks: {
+		func() apiMessage { return &types.ListLocksRequest{} },
+		func() apiMessage { return &types.ListLocksResponse{} },
+	},
+	types.Subscribe: {
+		func() apiMessage { return &types.SubscribeRequest{} },
+		func() apiMessage { return &types.SubscribeResponse{} },
+	},
+	types.Unsubscribe: {
+		func() apiMessage { return &types.UnsubscribeRequest{} },
+		func() apiMessage { return &types.UnsubscribeResponse{} },
+	},
+	types.ServiceAPIListRecordsRequest: {
+		func() apiMessage { return &types.ListRecordsRequest{} },
+		nil,
+	},
+	types.ServiceAPIListRecordsResponse: {
+		nil,
+		func() apiMessage { return &types.ListRecordsResponse{} },
+	},
+	types.ServiceAPIListVersionsRequest: {
+		func() apiMessage { return &types.ListRecordsRequest{} },
+		nil,
+	},
+	types.ServiceAPIListVersionsResponse: {
+		nil,
+		func() apiMessage { return &types.ListRecordsResponse{} },
+	},
+}
+
+// jsonRequest was the Request of the json encoding, the Data was the json of the message instead of the protobuf
+type jsonRequest struct {
+	Type      types.Type      `json:"type"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	Id        string          `json:"id"`
+	Timestamp int64           `json:"timestamp"`
+}
+
+// jsonResponse was the Response of the json encoding, the Data was the json of the message instead of the protobuf
+type jsonResponse struct {
+	Code      int32           `json:"code"`
+	Message   string          `json:"message"`
+	Type      types.Type      `json:"type"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	Id        string          `json:"id"`
+	Timestamp int64           `json:"timestamp"`
+	Broadcast bool            `json:"broadcast"`
+}
+
+// negotiateEncoding returns the encoding of the connection by the subprotocol, or by the query `encoding`.
+// The header was the response header of the upgrading which confirms the subprotocol.
+func negotiateEncoding(r *http.Request) (encoding string, header http.Header, err error) {
+	for _, v := range websocket.Subprotocols(r) {
+		if v == types.EncodingProtobuf || v == types.EncodingJSON {
+			return v, http.Header{"Sec-Websocket-Protocol": []string{v}}, nil
+		}
+	}
+	switch e := r.URL.Query().Get("encoding"); e {
+	case "", types.EncodingProtobuf:
+		return types.EncodingProtobuf, nil, nil
+	case types.EncodingJSON:
+		return types.EncodingJSON, nil, nil
+	default:
+		return "", nil, newError(types.CodeInvalid, ErrEncodingWasInvalid, e)
+	}
+}
+
+// messageType returns the websocket message type of the frames of the encoding
+func messageType(encoding string) int {
+	if encoding == types.EncodingJSON {
+		return websocket.TextMessage
+	}
+	return websocket.BinaryMessage
+}
+
+// decodeRequest converts the frame of the encoding into the protobuf Request
+func decodeRequest(encoding string, frame []byte) ([]byte, error) {
+	if encoding != types.EncodingJSON {
+		return frame, nil
+	}
+	in := &jsonRequest{}
+	if err := json.Unmarshal(frame, in); err != nil {
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	req := &types.Request{
+		Type:      in.Type,
+		Id:        in.Id,
+		Timestamp: in.Timestamp,
+	}
+	if len(in.Data) > 0 {
+		m, ok := serviceAPIMessages[in.Type.ServiceAPI]
+		if !ok || m.request == nil {
+			return nil, newError(types.CodeInvalid, ErrServiceAPIWasInvalid, in.Type.ServiceAPI)
+		}
+		data := m.request()
+		if err := json.Unmarshal(in.Data, data); err != nil {
+			return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+		}
+		var err error
+		if req.Data, err = data.Marshal(); err != nil {
+			return nil, err
+		}
+	}
+	return req.Marshal()
+}
+
+// encodeResponse converts the protobuf Response into the frame of the encoding
+func encodeResponse(encoding string, msg []byte) ([]byte, error) {
+	if encoding != types.EncodingJSON {
+		return msg, nil
+	}
+	res := &types.Response{}
+	if err := res.Unmarshal(msg); err != nil {
+		return nil, err
+	}
+	out := &jsonResponse{
+		Code:      res.Code,
+		Message:   res.Message,
+		Type:      res.Type,
+		Id:        res.Id,
+		Timestamp: res.Timestamp,
+		Broadcast: res.Broadcast,
+	}
+	if len(res.Data) > 0 {
+		m := serviceAPIMessages[res.Type.ServiceAPI]
+		factory := m.response
+		if res.Broadcast {
+			factory = m.request
+		}
+		if factory == nil {
+			return nil, newError(types.CodeInternal, ErrServiceAPIWasNotEncoding, res.Type.ServiceAPI)
+		}
+		data := factory()
+		if err := data.Unmarshal(res.Data); err != nil {
+			return nil, err
+		}
+		var err error
+		if out.Data, err = json.Marshal(data); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(out)
+}
+
+// frames caches the encoded frames of a broadcast by the encodings of the connections
+type frames struct {
+	msg   []byte
+	items map[string][]byte
+}
+
+func newFrames(msg []byte) *frames {
+	return &frames{
+		msg:   msg,
+		items: make(map[string][]byte, 0),
+	}
+}
+
+// get returns the frame of the encoding, it was nil if the msg couldn't be encoded
+func (f *frames) get(encoding string) []byte {
+	if frame, ok := f.items[encoding]; ok {
+		return frame
+	}
+	frame, err := encodeResponse(encoding, f.msg)
+	if err != nil {
+		klog.V(2).Info(err)
+	}
+	f.items[encoding] = frame
+	return frame
+}
@@ -0,0 +1,118 @@
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func Test_decodeRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding string
+		frame    string
+		want     *types.RunStepRequest
+		wantErr  bool
+	}{
+		{
+			name:     "Test_decodeRequest_1",
+			encoding: types.EncodingJSON,
+			frame:    `{"type":{"body":"Dashboard","serviceApi":"RunStep"},"id":"1","data":{"namespace":"ns1","groupName":"g1","step":{"name":"build","envs":{"A":"1"}}}}`,
+			want: &types.RunStepRequest{
+				Namespace: "ns1",
+				GroupName: "g1",
+				Step:      types.Step{Name: "build", Envs: map[string]string{"A": "1"}},
+			},
+		},
+		{
+			name:     "Test_decodeRequest_2",
+			encoding: types.EncodingJSON,
+			frame:    `{"type":{"serviceApi":"Unknown"},"data":{}}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test_decodeRequest_3",
+			encoding: types.EncodingJSON,
+			frame:    `not json`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeRequest(tt.encoding, []byte(tt.frame))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("decodeRequest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if errorCode(err) != types.CodeInvalid {
+					t.Errorf("decodeRequest() code = %v, want %v", errorCode(err), types.CodeInvalid)
+				}
+				return
+			}
+			req := &types.Request{}
+			if err = req.Unmarshal(got); err != nil {
+				t.Fatal(err)
+			}
+			data := &types.RunStepRequest{}
+			if err = data.Unmarshal(req.Data); err != nil {
+				t.Fatal(err)
+			}
+			if req.Id != "1" || !reflect.DeepEqual(data, tt.want) {
+				t.Errorf("decodeRequest() = %v %v, want %v", req.Id, data, tt.want)
+			}
+		})
+	}
+}
+
+func Test_encodeResponse(t *testing.T) {
+	marshal := func(m apiMessage) []byte {
+		data, err := m.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	tests := []struct {
+		name string
+		res  *types.Response
+		want string
+	}{
+		{
+			name: "Test_encodeResponse_1",
+			res: &types.Response{
+				Type: types.Type{ServiceAPI: types.ListNamespace},
+				Data: marshal(&types.ListNamespaceResponse{Items: []string{"ns1"}}),
+				Id:   "1",
+			},
+			want: `{"code":0,"message":"","type":{"body":"","serviceApi":"ListNamespace"},"data":{"items":["ns1"]},"id":"1","timestamp":0,"broadcast":false}`,
+		},
+		{
+			name: "Test_encodeResponse_2",
+			res: &types.Response{
+				Type:      types.Type{ServiceAPI: types.CancelStep},
+				Data:      marshal(&types.CancelStepRequest{Namespace: "ns1", GroupName: "g1", RunnerName: "r1", StepName: "build"}),
+				Broadcast: true,
+			},
+			want: `{"code":0,"message":"","type":{"body":"","serviceApi":"CancelStep"},"data":{"namespace":"ns1","groupName":"g1","runnerName":"r1","stepName":"build"},"id":"","timestamp":0,"broadcast":true}`,
+		},
+		{
+			name: "Test_encodeResponse_3",
+			res:  errorResponse(types.Type{ServiceAPI: types.ListRunner}, origin{id: "2"}, newError(types.CodeNotFound, ErrNamespaceWasNotExisted, "ns2")),
+			want: `{"code":404,"message":"error: namespace:ns2 was not existed","type":{"body":"","serviceApi":"ListRunner"},"id":"2","timestamp":0,"broadcast":false}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encodeResponse(types.EncodingJSON, marshal(tt.res))
+			if err != nil {
+				t.Errorf("encodeResponse() error = %v", err)
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("encodeResponse() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -13,10 +13,6 @@ import (
 	"time"
 )
 
-const (
-	WebsocketConnectionTimeout = 10
-)
-
 var upGrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024 * 1024 * 10,
@@ -52,17 +48,21 @@ type connections struct {
 type broadcastType string
 
 const (
-	broadcastTypeBindRunner broadcastType = "bindRunner"
-	broadcastTypePing       broadcastType = "ping"
-	broadcastTypeDashboard  broadcastType = "dashboard"
-	broadcastTypeRunner     broadcastType = "runner"
+	broadcastTypePing      broadcastType = "ping"
+	broadcastTypeDashboard broadcastType = "dashboard"
+	// broadcastTypeRunner sends the msg to the connection of the clientId
+	broadcastTypeRunner broadcastType = "runner"
+	// broadcastTypeClose closes the connection of the clientId, such as the Runner which was replaced by its duplicate.
+	// The msg would be written before the connection was closed if it wasn't nil
+	broadcastTypeClose broadcastType = "close"
 )
 
 type broadcast struct {
-	bt         broadcastType
-	clientId   int32
-	runnerName string
-	msg        []byte
+	bt       broadcastType
+	clientId int32
+	msg      []byte
+	// scope routes the broadcastTypeDashboard to the matched subscribers, the nil one would be sent to all dashboards
+	scope *types.Subscription
 }
 
 func (cs *connections) broadcastToDashboard() {
@@ -73,14 +73,6 @@ func (cs *connections) broadcastToDashboard() {
 				return
 			}
 			switch broadcast.bt {
-			case broadcastTypeBindRunner:
-				cs.mu.RLock()
-				if t, ok := cs.items[broadcast.clientId]; ok {
-					t.runnerName = broadcast.runnerName
-				} else {
-					// todo handle err
-				}
-				cs.mu.RUnlock()
 			case broadcastTypePing:
 				cs.mu.RLock()
 				if t, ok := cs.items[broadcast.clientId]; ok {
@@ -91,18 +83,39 @@ func (cs *connections) broadcastToDashboard() {
 				}
 				cs.mu.RUnlock()
 			case broadcastTypeDashboard:
+				// the msg would be encoded once for each encoding of the connections
+				f := newFrames(broadcast.msg)
 				cs.mu.RLock()
 				for _, v := range cs.items {
-					if v.body == types.BodyDashboard {
-						v.writeChan <- broadcast.msg
+					if v.body == types.BodyDashboard && cs.scheduler.subscriptions.matches(v.id, broadcast.scope) {
+						if frame := f.get(v.encoding); frame != nil {
+							v.writeChan <- frame
+						}
 					}
 				}
 				cs.mu.RUnlock()
 			case broadcastTypeRunner:
 				cs.mu.RLock()
-				for _, v := range cs.items {
-					if v.runnerName == broadcast.runnerName {
-						v.writeChan <- broadcast.msg
+				if t, ok := cs.items[broadcast.clientId]; ok {
+					if frame := newFrames(broadcast.msg).get(t.encoding); frame != nil {
+						t.writeChan <- frame
+					}
+				}
+				cs.mu.RUnlock()
+			case broadcastTypeClose:
+				cs.mu.RLock()
+				if t, ok := cs.items[broadcast.clientId]; ok {
+					var frame []byte
+					if broadcast.msg != nil {
+						frame = newFrames(broadcast.msg).get(t.encoding)
+					}
+					if frame != nil {
+						// the nil frame closes the connection after the msg was written by the writePump
+						t.writeChan <- frame
+						t.writeChan <- nil
+					} else {
+						// the close would remove the Runner by the Scheduler which may be sending the broadcasts
+						go t.close()
 					}
 				}
 				cs.mu.RUnlock()
@@ -129,8 +142,9 @@ func (cs *connections) remove() {
 	}
 }
 
-func (cs *connections) handlerDashboard(w http.ResponseWriter, r *http.Request) {
-	c, err := cs.newConn(w, r, types.BodyDashboard)
+// handlerDashboard serves the dashboard, the token would be checked by the permissions of managing the namespaces
+func (cs *connections) handlerDashboard(w http.ResponseWriter, r *http.Request, token string) {
+	c, err := cs.newConn(w, r, types.BodyDashboard, token)
 	if err != nil {
 		klog.V(2).Info(err)
 		return
@@ -141,7 +155,7 @@ func (cs *connections) handlerDashboard(w http.ResponseWriter, r *http.Request)
 }
 
 func (cs *connections) handlerRunner(w http.ResponseWriter, r *http.Request) {
-	c, err := cs.newConn(w, r, types.BodyRunner)
+	c, err := cs.newConn(w, r, types.BodyRunner, "")
 	if err != nil {
 		klog.V(2).Info(err)
 		return
@@ -151,8 +165,14 @@ func (cs *connections) handlerRunner(w http.ResponseWriter, r *http.Request) {
 	cs.items[c.id] = c
 }
 
-func (cs *connections) newConn(w http.ResponseWriter, r *http.Request, body types.Body) (*conn, error) {
-	client, err := upGrader.Upgrade(w, r, nil)
+func (cs *connections) newConn(w http.ResponseWriter, r *http.Request, body types.Body, token string) (*conn, error) {
+	encoding, header, err := negotiateEncoding(r)
+	if err != nil {
+		klog.V(2).Info(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, err
+	}
+	client, err := upGrader.Upgrade(w, r, header)
 	if err != nil {
 		klog.V(2).Info(err)
 		return nil, err
@@ -161,11 +181,13 @@ func (cs *connections) newConn(w http.ResponseWriter, r *http.Request, body type
 	c := &conn{
 		scheduler:             cs.scheduler,
 		body:                  body,
+		encoding:              encoding,
+		token:                 token,
 		id:                    atomic.AddInt32(&cs.autoIncrementId, 1),
 		conn:                  client,
 		writeChan:             make(chan []byte, 4096),
 		lastPingTime:          time.Now(),
-		keepAliveTimeoutInSec: WebsocketConnectionTimeout,
+		keepAliveTimeoutInSec: types.WebsocketConnectionTimeout,
 		closeOnce:             sync.Once{},
 		removedChan:           cs.removedChan,
 		ctx:                   ctx,
@@ -181,8 +203,9 @@ func (cs *connections) newConn(w http.ResponseWriter, r *http.Request, body type
 type conn struct {
 	scheduler             *Scheduler
 	body                  types.Body
+	encoding              string
+	token                 string
 	id                    int32
-	runnerName            string
 	conn                  *websocket.Conn
 	writeChan             chan []byte
 	lastPingTime          time.Time
@@ -219,6 +242,7 @@ func (c *conn) close() {
 		c.cancel()
 		c.removedChan <- c.id
 		c.scheduler.removeRunner(c.id)
+		c.scheduler.subscriptions.drop(c.id)
 		if err := c.conn.Close(); err != nil {
 			klog.V(2).Info(err)
 		}
@@ -235,13 +259,26 @@ func (c *conn) readPump() {
 			klog.V(2).Info(err)
 			return
 		}
-		res, err := c.scheduler.handle(data, c.id)
+		// the failed Request was answered by the Response, so the connection would be kept
+		var res []byte
+		if data, err = decodeRequest(c.encoding, data); err != nil {
+			klog.V(2).Info(err)
+			res, err = errorResponse(types.Type{}, origin{}, err).Marshal()
+		} else {
+			res, err = c.scheduler.handle(data, c.id, c.body, c.token)
+		}
 		if err != nil {
-			return
+			klog.V(2).Info(err)
+			continue
 		}
-		if len(res) > 0 {
-			c.writeChan <- res
+		if len(res) == 0 {
+			continue
 		}
+		if res, err = encodeResponse(c.encoding, res); err != nil {
+			klog.V(2).Info(err)
+			continue
+		}
+		c.writeChan <- res
 	}
 }
 
@@ -250,10 +287,10 @@ func (c *conn) writePump() {
 	for {
 		select {
 		case msg, isClose := <-c.writeChan:
-			if !isClose {
+			if !isClose || msg == nil {
 				return
 			}
-			if err := c.conn.WriteMessage(websocket.BinaryMessage, msg); err != nil {
+			if err := c.conn.WriteMessage(messageType(c.encoding), msg); err != nil {
 				klog.V(2).Info(err)
 				return
 			}
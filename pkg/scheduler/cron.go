@@ -0,0 +1,146 @@
+package scheduler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+const (
+	ErrCronWasInvalid = "error: cron:%s was invalid"
+)
+
+// cronField was the bounds of a field of the cron expression
+type cronField struct {
+	min, max int
+}
+
+var (
+	cronMinute = cronField{0, 59}
+	cronHour   = cronField{0, 23}
+	cronDom    = cronField{1, 31}
+	cronMonth  = cronField{1, 12}
+	cronDow    = cronField{0, 7}
+)
+
+// cronExpr was the parsed standard 5 fields cron expression: minute hour day-of-month month day-of-week.
+// Each field supports `*`, `n`, `a-b`, `*/s`, `a-b/s` and the comma separated lists of them.
+// The 0 and 7 of the day-of-week were both Sunday.
+type cronExpr struct {
+	minute, hour, dom, month, dow uint64
+	// domStar and dowStar were set when the field was `*`, because a Step would be fired
+	// when either of them matched if both of them were restricted
+	domStar, dowStar bool
+}
+
+func parseCron(in string) (*cronExpr, error) {
+	fields := strings.Fields(in)
+	if len(fields) != 5 {
+		return nil, newError(types.CodeInvalid, ErrCronWasInvalid, in)
+	}
+	c := &cronExpr{
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}
+	var err error
+	for i, v := range []struct {
+		bits  *uint64
+		field cronField
+	}{
+		{&c.minute, cronMinute},
+		{&c.hour, cronHour},
+		{&c.dom, cronDom},
+		{&c.month, cronMonth},
+		{&c.dow, cronDow},
+	} {
+		if *v.bits, err = parseCronField(fields[i], v.field); err != nil {
+			return nil, newError(types.CodeInvalid, ErrCronWasInvalid, in)
+		}
+	}
+	// Sunday
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	return c, nil
+}
+
+func parseCronField(in string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(in, ",") {
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s <= 0 {
+				return 0, newError(types.CodeInvalid, ErrCronWasInvalid, in)
+			}
+			step = s
+			part = part[:i]
+		}
+		start, end := f.min, f.max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			t := strings.SplitN(part, "-", 2)
+			var err1, err2 error
+			start, err1 = strconv.Atoi(t[0])
+			end, err2 = strconv.Atoi(t[1])
+			if err1 != nil || err2 != nil {
+				return 0, newError(types.CodeInvalid, ErrCronWasInvalid, in)
+			}
+		default:
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return 0, newError(types.CodeInvalid, ErrCronWasInvalid, in)
+			}
+			start, end = n, n
+			if step > 1 {
+				end = f.max
+			}
+		}
+		if start < f.min || end > f.max || start > end {
+			return 0, newError(types.CodeInvalid, ErrCronWasInvalid, in)
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func (c *cronExpr) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next returns the first time which was later than the t and matched the expression.
+// It returns the zero time if there wasn't any matched time in the next 5 years, such as `0 0 30 2 *`.
+func (c *cronExpr) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_cronExpr_Next(t *testing.T) {
+	// 2021-03-05 was a Friday
+	from := time.Date(2021, 3, 5, 10, 30, 20, 0, time.UTC)
+	tests := []struct {
+		name    string
+		cron    string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "Test_cronExpr_Next_1",
+			cron: "* * * * *",
+			want: time.Date(2021, 3, 5, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			name: "Test_cronExpr_Next_2",
+			cron: "*/15 * * * *",
+			want: time.Date(2021, 3, 5, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			name: "Test_cronExpr_Next_3",
+			cron: "30 2 * * *",
+			want: time.Date(2021, 3, 6, 2, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Test_cronExpr_Next_4",
+			cron: "0 9 * * 1-5",
+			want: time.Date(2021, 3, 8, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Test_cronExpr_Next_5",
+			cron: "0 0 1,15 * 7",
+			want: time.Date(2021, 3, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Test_cronExpr_Next_6",
+			cron: "0 0 29 2 *",
+			want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Test_cronExpr_Next_7",
+			cron: "0 0 30 2 *",
+			want: time.Time{},
+		},
+		{
+			name:    "Test_cronExpr_Next_8",
+			cron:    "60 * * * *",
+			wantErr: true,
+		},
+		{
+			name:    "Test_cronExpr_Next_9",
+			cron:    "* * * *",
+			wantErr: true,
+		},
+		{
+			name:    "Test_cronExpr_Next_10",
+			cron:    "5-1 * * * *",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := parseCron(tt.cron)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseCron() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := c.Next(from); !got.Equal(tt.want) {
+				t.Errorf("cronExpr.Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package scheduler
+
+import (
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DuplicatePolicyReject answers the newcomer with an error, and registers it after the registered one disconnected
+	DuplicatePolicyReject = "reject"
+	// DuplicatePolicyReplace registers the newcomer and closes the connection of the registered one with the CodeGone
+	DuplicatePolicyReplace = "replace"
+	// DuplicatePolicyPool keeps both of the connections, and each Step would be sent to one of them in turn
+	DuplicatePolicyPool = "pool"
+)
+
+const (
+	ErrDuplicatePolicyWasInvalid = "error: duplicate policy:%s was invalid"
+	ErrRunnerWasDuplicated       = "error: namespace:%s groupName:%s runner:%s was registered by hostname:%s, it was pending until the registered one disconnected"
+	ErrRunnerWasNotConnected     = "error: namespace:%s groupName:%s runner:%s was not connected"
+	ErrRunnerWasReplaced         = "error: namespace:%s groupName:%s runner:%s was replaced by hostname:%s"
+)
+
+// validDuplicatePolicy reports whether the policy was known, the empty one was the DuplicatePolicyReject
+func validDuplicatePolicy(policy string) bool {
+	switch policy {
+	case "", DuplicatePolicyReject, DuplicatePolicyReplace, DuplicatePolicyPool:
+		return true
+	}
+	return false
+}
+
+// addSession the caller must hold the s.mu
+func (g *Group) addSession(name string, clientId int32) {
+	g.Ids[clientId] = name
+	g.sessions[name] = append(g.sessions[name], clientId)
+}
+
+// deleteSession reports whether the Runner had no connections anymore, the caller must hold the s.mu
+func (g *Group) deleteSession(name string, clientId int32) bool {
+	delete(g.Ids, clientId)
+	ids := make([]int32, 0, len(g.sessions[name]))
+	for _, v := range g.sessions[name] {
+		if v != clientId {
+			ids = append(ids, v)
+		}
+	}
+	if len(ids) == 0 {
+		delete(g.sessions, name)
+		return true
+	}
+	g.sessions[name] = ids
+	return false
+}
+
+// nextSession returns the connection which the next Step of the Runner would be sent to,
+// the connections of the DuplicatePolicyPool would be used in turn
+func (s *Scheduler) nextSession(namespace types.Namespace, groupName types.GroupName, runnerName string) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, err := s.group(namespace, groupName)
+	if err != nil {
+		return 0, err
+	}
+	ids := g.sessions[runnerName]
+	if len(ids) == 0 {
+		return 0, newError(types.CodeNotFound, ErrRunnerWasNotConnected, namespace, groupName, runnerName)
+	}
+	id := ids[0]
+	g.sessions[runnerName] = append(ids[1:len(ids):len(ids)], id)
+	return id, nil
+}
+
+// sessionsOf returns all the connections of the Runner
+func (s *Scheduler) sessionsOf(namespace types.Namespace, groupName types.GroupName, runnerName string) ([]int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, err := s.group(namespace, groupName)
+	if err != nil {
+		return nil, err
+	}
+	ids := g.sessions[runnerName]
+	if len(ids) == 0 {
+		return nil, newError(types.CodeNotFound, ErrRunnerWasNotConnected, namespace, groupName, runnerName)
+	}
+	return append([]int32{}, ids...), nil
+}
+
+// registerDuplicate handles the Runner which registered with the name of the registered one by the policy of the Group,
+// and syncs the DuplicateRunnerEvent to all dashboards. The caller must hold the s.mu
+func (s *Scheduler) registerDuplicate(g *Group, old, ri *types.RunnerInfo, clientId int32) (err error) {
+	for _, v := range g.sessions[ri.Name] {
+		if v == clientId {
+			// the Runner registered again by the same connection
+			return nil
+		}
+	}
+	event := &types.DuplicateRunnerEvent{
+		Namespace:        ri.Namespace,
+		GroupName:        ri.GroupName,
+		RunnerName:       ri.Name,
+		Hostname:         ri.Hostname,
+		PreviousHostname: old.Hostname,
+		Policy:           g.duplicate,
+	}
+	switch g.duplicate {
+	case DuplicatePolicyReplace:
+		// the replaced one would be told not to redial by the CodeGone, otherwise the two hosts would replace each other forever
+		msg, e := errorResponse(types.Type{ServiceAPI: types.RegisterRunner}, origin{},
+			newError(types.CodeGone, ErrRunnerWasReplaced, ri.Namespace, ri.GroupName, ri.Name, ri.Hostname)).Marshal()
+		if e != nil {
+			klog.V(2).Info(e)
+		}
+		for _, v := range g.sessions[ri.Name] {
+			g.deleteSession(ri.Name, v)
+			s.broadcast <- &broadcast{
+				bt:       broadcastTypeClose,
+				clientId: v,
+				msg:      msg,
+			}
+		}
+		// the replaced one was removed as same as it disconnected
+		s.dropRunner(g, ri.Namespace, ri.GroupName, ri.Name)
+		g.Runners[ri.Name] = ri
+		g.addRunner(ri)
+		g.addSession(ri.Name, clientId)
+		s.persistRunner(ri)
+	case DuplicatePolicyPool:
+		g.addSession(ri.Name, clientId)
+	default:
+		event.Policy = DuplicatePolicyReject
+		s.pending[clientId] = ri
+		err = newError(types.CodeBusy, ErrRunnerWasDuplicated, ri.Namespace, ri.GroupName, ri.Name, old.Hostname)
+	}
+	klog.Infof("duplicate runner:%s namespace:%s groupName:%s hostname:%s previousHostname:%s policy:%s",
+		ri.Name, ri.Namespace, ri.GroupName, ri.Hostname, old.Hostname, event.Policy)
+	data, e := event.Marshal()
+	if e != nil {
+		klog.V(2).Info(e)
+		return err
+	}
+	scope := &types.Subscription{
+		Namespace:  ri.Namespace,
+		GroupName:  ri.GroupName,
+		RunnerName: ri.Name,
+	}
+	if e = s.broadcastToSubscribers(types.DuplicateRunner, data, scope, origin{}); e != nil {
+		klog.V(2).Info(e)
+	}
+	return err
+}
+
+// adoptable reports whether the pending Runner could be registered, the caller must hold the s.mu
+func (s *Scheduler) adoptable(ri *types.RunnerInfo) bool {
+	g, err := s.group(ri.Namespace, ri.GroupName)
+	if err != nil || g.retired {
+		return false
+	}
+	_, ok := g.Runners[ri.Name]
+	return !ok || (g.duplicate != "" && g.duplicate != DuplicatePolicyReject)
+}
@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestScheduler_registerDuplicate(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       string
+		wantCode     int32
+		wantSessions []int32
+		wantPending  bool
+		wantHostname string
+		wantGone     bool
+	}{
+		{
+			name:         "TestScheduler_registerDuplicate_1",
+			policy:       "",
+			wantCode:     types.CodeBusy,
+			wantSessions: []int32{1},
+			wantPending:  true,
+			wantHostname: "old",
+		},
+		{
+			name:         "TestScheduler_registerDuplicate_2",
+			policy:       DuplicatePolicyReplace,
+			wantSessions: []int32{2},
+			wantHostname: "new",
+			wantGone:     true,
+		},
+		{
+			name:         "TestScheduler_registerDuplicate_3",
+			policy:       DuplicatePolicyPool,
+			wantSessions: []int32{1, 2},
+			wantHostname: "old",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broadcasts := make(chan *broadcast, 10)
+			s := &Scheduler{
+				broadcast: broadcasts,
+				states:    newPendingStates(),
+				pending:   make(map[int32]*types.RunnerInfo, 0),
+			}
+			g := newGroup(GroupModeDefault, tt.policy, true, nil, nil)
+			old := &types.RunnerInfo{Name: "r1", Hostname: "old"}
+			g.Runners[old.Name] = old
+			g.addRunner(old)
+			g.addSession(old.Name, 1)
+			g.gates[gateKey(old.Name, "build")] = &gate{req: &types.RunStepRequest{RunnerName: old.Name}}
+			err := s.registerDuplicate(g, old, &types.RunnerInfo{Name: "r1", Hostname: "new"}, 2)
+			if tt.wantCode != types.CodeOK {
+				if code := errorCode(err); err == nil || code != tt.wantCode {
+					t.Errorf("registerDuplicate() error = %v, wantCode %v", err, tt.wantCode)
+				}
+			} else if err != nil {
+				t.Errorf("registerDuplicate() error = %v", err)
+			}
+			if got := g.sessions["r1"]; !reflect.DeepEqual(got, tt.wantSessions) {
+				t.Errorf("registerDuplicate() sessions = %v, want %v", got, tt.wantSessions)
+			}
+			if _, ok := s.pending[2]; ok != tt.wantPending {
+				t.Errorf("registerDuplicate() pending = %v, want %v", ok, tt.wantPending)
+			}
+			if got := g.Runners["r1"].Hostname; got != tt.wantHostname {
+				t.Errorf("registerDuplicate() hostname = %v, want %v", got, tt.wantHostname)
+			}
+			if _, ok := g.gates[gateKey(old.Name, "build")]; ok == tt.wantGone {
+				t.Errorf("registerDuplicate() gate = %v, want it dropped %v", ok, tt.wantGone)
+			}
+			// the last broadcast was the DuplicateRunnerEvent to the dashboards
+			var last *broadcast
+			gone := false
+			for len(broadcasts) > 0 {
+				last = <-broadcasts
+				if last.bt == broadcastTypeClose {
+					res := &types.Response{}
+					gone = last.clientId == 1 && res.Unmarshal(last.msg) == nil && res.Code == types.CodeGone
+				}
+			}
+			if last == nil || last.bt != broadcastTypeDashboard {
+				t.Errorf("registerDuplicate() the event wasn't broadcast")
+			}
+			if gone != tt.wantGone {
+				t.Errorf("registerDuplicate() the replaced connection was closed by the CodeGone = %v, want %v", gone, tt.wantGone)
+			}
+		})
+	}
+}
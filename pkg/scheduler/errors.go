@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+const (
+	ErrRequestWasInvalid      = "error: request was invalid err:%v"
+	ErrServiceAPIWasInvalid   = "error: serviceApi:%s was invalid"
+	ErrServiceAPIWasForbidden = "error: serviceApi:%s was forbidden for body:%s"
+)
+
+// Error was the error which would be answered by the Code and the Message of the Response
+type Error struct {
+	Code    int32
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newError(code int32, format string, a ...interface{}) error {
+	return &Error{
+		Code:    code,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// errorCode returns the Code of the err, it was CodeInternal if the err wasn't an *Error
+func errorCode(err error) int32 {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return types.CodeInternal
+}
+
+// errorResponse returns the Response of the failed Request
+func errorResponse(t types.Type, o origin, err error) *types.Response {
+	return &types.Response{
+		Code:      errorCode(err),
+		Message:   err.Error(),
+		Type:      t,
+		Id:        o.id,
+		Timestamp: o.timestamp,
+	}
+}
+
+// runnerServiceAPIs were the only ServiceAPIs which a Runner was allowed to send
+var runnerServiceAPIs = map[types.ServiceAPI]bool{
+	types.Ping:           true,
+	types.RegisterRunner: true,
+	types.UpdateStep:     true,
+	types.CompleteStep:   true,
+	types.LogStream:      true,
+}
+
+// runnerOnlyServiceAPIs were the ServiceAPIs which must be sent from a Runner
+var runnerOnlyServiceAPIs = map[types.ServiceAPI]bool{
+	types.RegisterRunner: true,
+	types.CompleteStep:   true,
+	types.LogStream:      true,
+}
+
+func allowedServiceAPI(api types.ServiceAPI, body types.Body) bool {
+	switch body {
+	case types.BodyRunner:
+		return runnerServiceAPIs[api]
+	case types.BodyDashboard:
+		return !runnerOnlyServiceAPIs[api]
+	}
+	return false
+}
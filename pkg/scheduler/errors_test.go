@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func Test_allowedServiceAPI(t *testing.T) {
+	tests := []struct {
+		name string
+		api  types.ServiceAPI
+		body types.Body
+		want bool
+	}{
+		{
+			name: "Test_allowedServiceAPI_1",
+			api:  types.UpdateStep,
+			body: types.BodyRunner,
+			want: true,
+		},
+		{
+			name: "Test_allowedServiceAPI_2",
+			api:  types.ListRunner,
+			body: types.BodyRunner,
+			want: false,
+		},
+		{
+			name: "Test_allowedServiceAPI_3",
+			api:  types.ListRunnerQueue,
+			body: types.BodyRunner,
+			want: false,
+		},
+		{
+			name: "Test_allowedServiceAPI_4",
+			api:  types.LogStream,
+			body: types.BodyDashboard,
+			want: false,
+		},
+		{
+			name: "Test_allowedServiceAPI_5",
+			api:  types.UpdateStep,
+			body: types.BodyDashboard,
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allowedServiceAPI(tt.api, tt.body); got != tt.want {
+				t.Errorf("allowedServiceAPI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package scheduler
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// HookMaxPayloadSize was the max size of the body of a webhook request
+	HookMaxPayloadSize = 5 << 20
+)
+
+const (
+	ErrHookWasNotExisted       = "error: namespace:%s groupName:%s hook:%s was not existed"
+	ErrHookWasDuplicated       = "error: namespace:%s groupName:%s hook:%s was duplicated"
+	ErrHookSecretWasRequired   = "error: namespace:%s groupName:%s hook:%s secret was required"
+	ErrHookSignatureWasInvalid = "error: hook:%s signature was invalid"
+	ErrHookPayloadWasInvalid   = "error: hook:%s payload was invalid err:%v"
+)
+
+// the headers of the webhook providers
+const (
+	headerGitHubEvent        = "X-GitHub-Event"
+	headerGitHubSignature    = "X-Hub-Signature-256"
+	headerGiteaEvent         = "X-Gitea-Event"
+	headerGiteaSignature     = "X-Gitea-Signature"
+	headerGitLabEvent        = "X-Gitlab-Event"
+	headerGitLabToken        = "X-Gitlab-Token"
+	headerPublisherSignature = "X-Publisher-Signature"
+)
+
+const (
+	hookProviderGitHub  = "github"
+	hookProviderGitea   = "gitea"
+	hookProviderGitLab  = "gitlab"
+	hookProviderGeneric = "generic"
+)
+
+// newHooks validates the declared hooks of a Group
+func newHooks(namespace types.Namespace, groupName types.GroupName, items []conf.Hook) (map[string]*conf.Hook, error) {
+	res := make(map[string]*conf.Hook, len(items))
+	for i, v := range items {
+		if _, ok := res[v.Name]; ok || v.Name == "" {
+			return nil, fmt.Errorf(ErrHookWasDuplicated, namespace, groupName, v.Name)
+		}
+		if v.Secret == "" {
+			return nil, fmt.Errorf(ErrHookSecretWasRequired, namespace, groupName, v.Name)
+		}
+		res[v.Name] = &items[i]
+	}
+	return res, nil
+}
+
+// hookProvider detects the sender of the webhook by the headers,
+// the Gitea must be checked before the GitHub because it sends the GitHub headers as well
+func hookProvider(header http.Header) string {
+	switch {
+	case header.Get(headerGiteaEvent) != "":
+		return hookProviderGitea
+	case header.Get(headerGitHubEvent) != "":
+		return hookProviderGitHub
+	case header.Get(headerGitLabEvent) != "":
+		return hookProviderGitLab
+	}
+	return hookProviderGeneric
+}
+
+func hmacSHA256(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifyHook checks the HMAC signature of the body, or the token of the GitLab
+func verifyHook(h *conf.Hook, header http.Header, body []byte) error {
+	var got, want string
+	switch hookProvider(header) {
+	case hookProviderGitea:
+		got, want = header.Get(headerGiteaSignature), hmacSHA256(h.Secret, body)
+	case hookProviderGitHub:
+		got, want = header.Get(headerGitHubSignature), "sha256="+hmacSHA256(h.Secret, body)
+	case hookProviderGitLab:
+		got, want = header.Get(headerGitLabToken), h.Secret
+	default:
+		got, want = header.Get(headerPublisherSignature), "sha256="+hmacSHA256(h.Secret, body)
+	}
+	if got == "" || !hmac.Equal([]byte(got), []byte(want)) {
+		return fmt.Errorf(ErrHookSignatureWasInvalid, h.Name)
+	}
+	return nil
+}
+
+// parseHookPayload returns the Envs of the Step from the payload.
+// The skipped was the reason why the payload wouldn't trigger running, such as a ping event or a filtered branch.
+func parseHookPayload(h *conf.Hook, header http.Header, body []byte) (envs map[string]string, skipped string, err error) {
+	provider := hookProvider(header)
+	switch provider {
+	case hookProviderGitea:
+		if e := header.Get(headerGiteaEvent); e != "push" {
+			return nil, "event " + e + " was ignored", nil
+		}
+	case hookProviderGitHub:
+		if e := header.Get(headerGitHubEvent); e != "push" {
+			return nil, "event " + e + " was ignored", nil
+		}
+	case hookProviderGitLab:
+		if e := header.Get(headerGitLabEvent); e != "Push Hook" {
+			return nil, "event " + e + " was ignored", nil
+		}
+	}
+	payload := make(map[string]interface{})
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if err = d.Decode(&payload); err != nil {
+		return nil, "", newError(types.CodeInvalid, ErrHookPayloadWasInvalid, h.Name, err)
+	}
+	envs = make(map[string]string, 0)
+	ref, _ := lookupPayload(payload, "ref")
+	branch := strings.TrimPrefix(ref, "refs/heads/")
+	if branch != ref {
+		envs[types.PublisherGitBranch] = branch
+	} else {
+		branch = ""
+	}
+	if provider != hookProviderGeneric {
+		if deleted, _ := lookupPayload(payload, "deleted"); deleted == "true" {
+			return nil, "the deleted branch was ignored", nil
+		}
+	}
+	if len(h.Branches) > 0 {
+		matched := false
+		for _, v := range h.Branches {
+			if ok, _ := path.Match(v, branch); ok && branch != "" {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil, "ref " + ref + " was ignored", nil
+		}
+	}
+	for k, v := range h.Envs {
+		if value, ok := lookupPayload(payload, v); ok {
+			envs[k] = value
+		}
+	}
+	return envs, "", nil
+}
+
+// lookupPayload returns the string value of the field at the dotted path, such as `repository.name`
+func lookupPayload(payload map[string]interface{}, key string) (string, bool) {
+	var cur interface{} = payload
+	for _, v := range strings.Split(key, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if cur, ok = m[v]; !ok {
+			return "", false
+		}
+	}
+	switch t := cur.(type) {
+	case nil:
+		return "", false
+	case string:
+		return t, true
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(t)
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+	default:
+		return fmt.Sprint(t), true
+	}
+}
+
+// handleHook returns the http status and the result of the webhook
+func (s *Scheduler) handleHook(namespace types.Namespace, groupName types.GroupName, name string, header http.Header, body []byte) (status int, result string, err error) {
+	g, err := s.getGroup(namespace, groupName)
+	if err != nil {
+		return http.StatusNotFound, "", err
+	}
+	// the hooks would be replaced by the reloading
+	s.mu.Lock()
+	h, ok := g.hooks[name]
+	s.mu.Unlock()
+	if !ok {
+		return http.StatusNotFound, "", newError(types.CodeNotFound, ErrHookWasNotExisted, namespace, groupName, name)
+	}
+	if err = verifyHook(h, header, body); err != nil {
+		return http.StatusUnauthorized, "", err
+	}
+	envs, skipped, err := parseHookPayload(h, header, body)
+	if err != nil {
+		return http.StatusBadRequest, "", err
+	}
+	if skipped != "" {
+		return http.StatusAccepted, skipped, nil
+	}
+	klog.Infof("handleHook namespace:%s groupName:%s hook:%s envs:%v", namespace, groupName, name, envs)
+	if _, err = s.runTarget(namespace, groupName, h.Runner, h.Step, "", envs); err != nil {
+		return http.StatusConflict, "", err
+	}
+	return http.StatusOK, "triggered", nil
+}
+
+func (s *Server) hook(c *gin.Context) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, HookMaxPayloadSize))
+	if err != nil {
+		klog.V(2).Info(err)
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		return
+	}
+	status, result, err := s.connections.scheduler.handleHook(types.Namespace(c.Param("namespace")),
+		types.GroupName(c.Param("group")), c.Param("name"), c.Request.Header, body)
+	if err != nil {
+		klog.V(2).Info(err)
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(status, gin.H{"result": result})
+}
@@ -0,0 +1,119 @@
+package scheduler
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func Test_parseHook(t *testing.T) {
+	h := &conf.Hook{
+		Name:     "push",
+		Secret:   "secret",
+		Branches: []string{"release/*"},
+		Envs:     map[string]string{"COMMIT": "after", "REPO": "repository.name"},
+	}
+	push := []byte(`{"ref":"refs/heads/release/1.0","after":"abc","repository":{"name":"publisher"}}`)
+	tests := []struct {
+		name        string
+		header      map[string]string
+		body        []byte
+		wantVerify  bool
+		wantEnvs    map[string]string
+		wantSkipped bool
+		wantErr     bool
+	}{
+		{
+			name: "Test_parseHook_GitHub",
+			header: map[string]string{
+				headerGitHubEvent:     "push",
+				headerGitHubSignature: "sha256=" + hmacSHA256("secret", push),
+			},
+			body:       push,
+			wantVerify: true,
+			wantEnvs:   map[string]string{types.PublisherGitBranch: "release/1.0", "COMMIT": "abc", "REPO": "publisher"},
+		},
+		{
+			name: "Test_parseHook_Gitea",
+			header: map[string]string{
+				headerGiteaEvent:      "push",
+				headerGitHubEvent:     "push",
+				headerGiteaSignature:  hmacSHA256("secret", push),
+				headerGitHubSignature: "sha256=wrong",
+			},
+			body:       push,
+			wantVerify: true,
+			wantEnvs:   map[string]string{types.PublisherGitBranch: "release/1.0", "COMMIT": "abc", "REPO": "publisher"},
+		},
+		{
+			name: "Test_parseHook_GitLab",
+			header: map[string]string{
+				headerGitLabEvent: "Push Hook",
+				headerGitLabToken: "wrong",
+			},
+			body:       push,
+			wantVerify: false,
+		},
+		{
+			name: "Test_parseHook_GitHub_Ping",
+			header: map[string]string{
+				headerGitHubEvent:     "ping",
+				headerGitHubSignature: "sha256=" + hmacSHA256("secret", []byte(`{}`)),
+			},
+			body:        []byte(`{}`),
+			wantVerify:  true,
+			wantSkipped: true,
+		},
+		{
+			name: "Test_parseHook_Generic_Branch",
+			header: map[string]string{
+				headerPublisherSignature: "sha256=" + hmacSHA256("secret", []byte(`{"ref":"refs/heads/main"}`)),
+			},
+			body:        []byte(`{"ref":"refs/heads/main"}`),
+			wantVerify:  true,
+			wantSkipped: true,
+		},
+		{
+			name: "Test_parseHook_Generic_Invalid",
+			header: map[string]string{
+				headerPublisherSignature: "sha256=" + hmacSHA256("secret", []byte(`not json`)),
+			},
+			body:       []byte(`not json`),
+			wantVerify: true,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.header {
+				header.Set(k, v)
+			}
+			if err := verifyHook(h, header, tt.body); (err == nil) != tt.wantVerify {
+				t.Errorf("verifyHook() error = %v, wantVerify %v", err, tt.wantVerify)
+				return
+			}
+			if !tt.wantVerify {
+				return
+			}
+			envs, skipped, err := parseHookPayload(h, header, tt.body)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseHookPayload() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (skipped != "") != tt.wantSkipped {
+				t.Errorf("parseHookPayload() skipped = %v, wantSkipped %v", skipped, tt.wantSkipped)
+				return
+			}
+			if tt.wantErr || tt.wantSkipped {
+				return
+			}
+			if !reflect.DeepEqual(envs, tt.wantEnvs) {
+				t.Errorf("parseHookPayload() envs = %v, want %v", envs, tt.wantEnvs)
+			}
+		})
+	}
+}
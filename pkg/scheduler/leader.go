@@ -0,0 +1,225 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/dao"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// LeaseScheduler was the name of the lease which was held by the leader of the Schedulers
+	LeaseScheduler = "scheduler"
+
+	DefaultLeaseDurationInSec = 15
+	DefaultRenewIntervalInSec = 5
+)
+
+const (
+	ErrAdvertiseAddrWasInvalid = "error: HA id:%s advertiseAddr:%s was invalid"
+	ErrRenewIntervalWasInvalid = "error: HA id:%s renewIntervalInSec:%d must be less than leaseDurationInSec:%d"
+	ErrLeaderWasNotElected     = "error: the leader of the schedulers was not elected yet"
+)
+
+// elector keeps the lease of the leader by renewing it in the interval, the onElected and the onDemoted
+// would be called after the instance became the leader or lost the lease
+type elector struct {
+	mu       sync.RWMutex
+	dao      *dao.Dao
+	id       string
+	addr     string
+	duration time.Duration
+	interval time.Duration
+	// leading was true if the lease was held by the instance itself
+	leading bool
+	// renewed was the local time when the lease was renewed last time
+	renewed time.Time
+	// leaderId and leaderAddr were the holder of the lease, they were empty if the lease was unknown
+	leaderId   string
+	leaderAddr string
+	// proxy sends the requests to the leaderAddr
+	proxy     *httputil.ReverseProxy
+	onElected func()
+	onDemoted func()
+}
+
+func newElector(d *dao.Dao, c conf.HA, onElected, onDemoted func()) (*elector, error) {
+	if u, err := url.Parse(c.AdvertiseAddr); err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, newError(types.CodeInvalid, ErrAdvertiseAddrWasInvalid, c.Id, c.AdvertiseAddr)
+	}
+	if c.LeaseDurationInSec == 0 {
+		c.LeaseDurationInSec = DefaultLeaseDurationInSec
+	}
+	if c.RenewIntervalInSec == 0 {
+		c.RenewIntervalInSec = DefaultRenewIntervalInSec
+	}
+	if c.RenewIntervalInSec <= 0 || c.RenewIntervalInSec >= c.LeaseDurationInSec {
+		return nil, newError(types.CodeInvalid, ErrRenewIntervalWasInvalid, c.Id, c.RenewIntervalInSec, c.LeaseDurationInSec)
+	}
+	return &elector{
+		dao:       d,
+		id:        c.Id,
+		addr:      c.AdvertiseAddr,
+		duration:  time.Second * time.Duration(c.LeaseDurationInSec),
+		interval:  time.Second * time.Duration(c.RenewIntervalInSec),
+		onElected: onElected,
+		onDemoted: onDemoted,
+	}, nil
+}
+
+// run renews the lease until the ctx was done, and then releases the lease for the followers
+func (e *elector) run(ctx context.Context) {
+	tick := time.NewTicker(e.interval)
+	defer tick.Stop()
+	for {
+		e.elect()
+		select {
+		case <-ctx.Done():
+			e.mu.RLock()
+			leading := e.leading
+			e.mu.RUnlock()
+			if leading {
+				if err := e.dao.ReleaseLease(LeaseScheduler, e.id); err != nil {
+					klog.V(2).Info(err)
+				}
+			}
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+func (e *elector) elect() {
+	l, err := e.dao.AcquireLease(LeaseScheduler, e.id, e.addr, e.duration)
+	e.mu.Lock()
+	was := e.leading
+	switch {
+	case err != nil:
+		klog.V(2).Info(err)
+		// the leader steps down one interval before the lease expired, so that it wouldn't overlap with the next one
+		e.leading = e.leading && time.Since(e.renewed) < e.duration-e.interval
+	case l.Holder == e.id:
+		e.leading = true
+		e.renewed = time.Now()
+	default:
+		e.leading = false
+	}
+	if err == nil && (l.Holder != e.leaderId || l.Addr != e.leaderAddr) {
+		e.leaderId, e.leaderAddr, e.proxy = l.Holder, l.Addr, nil
+		if u, err := url.Parse(l.Addr); err == nil {
+			e.proxy = httputil.NewSingleHostReverseProxy(u)
+		} else {
+			klog.V(2).Info(err)
+		}
+	}
+	leading := e.leading
+	e.mu.Unlock()
+	switch {
+	case leading && !was:
+		klog.Infof("elected as the leader id:%s addr:%s", e.id, e.addr)
+		e.onElected()
+	case !leading && was:
+		klog.Infof("demoted from the leader id:%s", e.id)
+		e.onDemoted()
+	}
+}
+
+func (s *Scheduler) setLeading(leading bool) {
+	var v int32
+	if leading {
+		v = 1
+	}
+	atomic.StoreInt32(&s.leading, v)
+}
+
+func (s *Scheduler) isLeading() bool {
+	return atomic.LoadInt32(&s.leading) == 1
+}
+
+// takeOver loads the projects and the Schedules which were saved by the previous leader,
+// the states of the Runners would be loaded after they reconnected.
+// The others were kept in the memory of the previous leader, and they were lost on purpose as the Runners disconnected from it:
+//   - the queued requests of the Runners and the ones of the GroupModePool were dropped
+//   - the gates awaiting the approvals, the held locks and their waiters were dropped,
+//     the Steps which were awaiting would be restored as StepUnknown by the mergeRunnerState
+//   - the progress of the pipelines was reset, the nodes after the running ones wouldn't be triggered
+func (s *Scheduler) takeOver() {
+	s.loadProjects()
+	s.loadSchedules()
+	s.setLeading(true)
+}
+
+// stepDown closes all the connections, the Runners and the dashboards would reconnect to the new leader by the proxy
+func (cs *connections) stepDown() {
+	cs.scheduler.setLeading(false)
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	for _, c := range cs.items {
+		go c.close()
+	}
+}
+
+// leaderStatus was the response of the HttpHandlerLeader
+type leaderStatus struct {
+	Id         string `json:"id"`
+	Leading    bool   `json:"leading"`
+	LeaderId   string `json:"leaderId"`
+	LeaderAddr string `json:"leaderAddr"`
+}
+
+func (e *elector) status() leaderStatus {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return leaderStatus{
+		Id:         e.id,
+		Leading:    e.leading,
+		LeaderId:   e.leaderId,
+		LeaderAddr: e.leaderAddr,
+	}
+}
+
+// proxyToLeader serves the requests by the leader if the instance was a follower, the websocket connections
+// of the Runners and the dashboards would be proxied as well, and they would be closed after the leader was changed
+func (s *Server) proxyToLeader(c *gin.Context) {
+	if s.elector == nil || c.Request.URL.Path == types.HttpHandlerLeader {
+		c.Next()
+		return
+	}
+	s.elector.mu.RLock()
+	leading, proxy := s.elector.leading, s.elector.proxy
+	// the instance which lost the lease wouldn't proxy to itself
+	if s.elector.leaderId == s.elector.id {
+		proxy = nil
+	}
+	s.elector.mu.RUnlock()
+	if leading {
+		c.Next()
+		return
+	}
+	if proxy == nil {
+		apiError(c, http.StatusServiceUnavailable, errors.New(ErrLeaderWasNotElected))
+		c.Abort()
+		return
+	}
+	proxy.ServeHTTP(c.Writer, c.Request)
+	c.Abort()
+}
+
+// leader shows the instance itself and the current leader
+func (s *Server) leader(c *gin.Context) {
+	if s.elector == nil {
+		c.JSON(http.StatusOK, leaderStatus{Leading: true})
+		return
+	}
+	c.JSON(http.StatusOK, s.elector.status())
+}
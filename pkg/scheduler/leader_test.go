@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+)
+
+func TestScheduler_newElector(t *testing.T) {
+	tests := []struct {
+		name         string
+		c            conf.HA
+		wantDuration time.Duration
+		wantInterval time.Duration
+		wantErr      bool
+	}{
+		{
+			name: "TestScheduler_newElector_1",
+			c: conf.HA{
+				Id:            "scheduler-0",
+				AdvertiseAddr: "http://127.0.0.1:6969",
+			},
+			wantDuration: time.Second * DefaultLeaseDurationInSec,
+			wantInterval: time.Second * DefaultRenewIntervalInSec,
+		},
+		{
+			name: "TestScheduler_newElector_2",
+			c: conf.HA{
+				Id:                 "scheduler-0",
+				AdvertiseAddr:      "http://127.0.0.1:6969",
+				LeaseDurationInSec: 30,
+				RenewIntervalInSec: 10,
+			},
+			wantDuration: time.Second * 30,
+			wantInterval: time.Second * 10,
+		},
+		{
+			name: "TestScheduler_newElector_3",
+			c: conf.HA{
+				Id:                 "scheduler-0",
+				AdvertiseAddr:      "http://127.0.0.1:6969",
+				LeaseDurationInSec: 5,
+				RenewIntervalInSec: 5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "TestScheduler_newElector_4",
+			c: conf.HA{
+				Id:            "scheduler-0",
+				AdvertiseAddr: "127.0.0.1:6969",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newElector(nil, tt.c, nil, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newElector() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.duration != tt.wantDuration || got.interval != tt.wantInterval {
+				t.Errorf("newElector() duration = %v interval = %v, want %v %v", got.duration, got.interval, tt.wantDuration, tt.wantInterval)
+			}
+		})
+	}
+}
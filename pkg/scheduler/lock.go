@@ -0,0 +1,211 @@
+package scheduler
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ErrLockWasHeld = "error: lock:%s was held by namespace:%s groupName:%s runner:%s step:%s"
+)
+
+// resourceLock was held by the running Step, it would be removed after it was released
+type resourceLock struct {
+	holder  types.LockClaim
+	waiters []*lockWaiter
+}
+
+// lockWaiter was the RunStepRequest which was waiting for the lock, its Runner was kept busy for it
+type lockWaiter struct {
+	claim types.LockClaim
+	req   *types.RunStepRequest
+}
+
+func newLockClaim(req *types.RunStepRequest) types.LockClaim {
+	return types.LockClaim{
+		Namespace:  req.Namespace,
+		GroupName:  req.GroupName,
+		RunnerName: req.RunnerName,
+		StepName:   req.Step.Name,
+		ClaimedTM:  time.Now().UnixNano() / int64(time.Millisecond),
+	}
+}
+
+// claimedBy reports whether the claim was made by the Step, the empty stepName matches all the Steps of the Runner
+func claimedBy(c types.LockClaim, namespace types.Namespace, groupName types.GroupName, runnerName, stepName string) bool {
+	return c.Namespace == namespace && c.GroupName == groupName && c.RunnerName == runnerName &&
+		(stepName == "" || c.StepName == stepName)
+}
+
+// acquireLocks holds all the locks of the Step or none of them, it returns the first one which was held by the others.
+// The caller must hold the s.mu
+func (s *Scheduler) acquireLocks(req *types.RunStepRequest, names []string) (held string) {
+	for _, name := range names {
+		if l, ok := s.locks[name]; ok && !claimedBy(l.holder, req.Namespace, req.GroupName, req.RunnerName, req.Step.Name) {
+			return name
+		}
+	}
+	claim := newLockClaim(req)
+	for _, name := range names {
+		if _, ok := s.locks[name]; !ok {
+			s.locks[name] = &resourceLock{holder: claim}
+		}
+	}
+	return ""
+}
+
+// awaitLock rejects the request or keeps its Runner busy until the held lock was released by the LockPolicy of the Step,
+// the caller must hold the s.mu
+func (s *Scheduler) awaitLock(g *Group, ri *types.RunnerInfo, req *types.RunStepRequest, held string, policy types.StepLockPolicy, o origin) (res []byte, err error) {
+	l := s.locks[held]
+	if policy == types.StepLockPolicyReject {
+		return nil, newError(types.CodeBusy, ErrLockWasHeld, held, l.holder.Namespace, l.holder.GroupName, l.holder.RunnerName, l.holder.StepName)
+	}
+	l.waiters = append(l.waiters, &lockWaiter{
+		claim: newLockClaim(req),
+		req:   req.DeepCopy(),
+	})
+	g.markRunning(req.RunnerName, req.Step.Name, 0)
+	for i, v := range ri.Steps {
+		if v.Name != req.Step.Name {
+			continue
+		}
+		v.Phase = types.StepAwaitingLock
+		ri.Steps[i] = v
+		if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, v.DeepCopy(), o); err != nil {
+			klog.V(2).Info(err)
+		}
+	}
+	s.persistRunner(ri)
+	klog.Infof("await lock:%s step:%s runner:%s namespace:%s groupName:%s holder:%s/%s/%s/%s", held, req.Step.Name, req.RunnerName, req.Namespace, req.GroupName,
+		l.holder.Namespace, l.holder.GroupName, l.holder.RunnerName, l.holder.StepName)
+	result := &types.RunStepResponse{
+		AwaitingLock: held,
+	}
+	return result.Marshal()
+}
+
+// unlock removes the locks which were held by the claims that matched, and returns all the waiters of them in order.
+// The caller must hold the s.mu
+func (s *Scheduler) unlock(namespace types.Namespace, groupName types.GroupName, runnerName, stepName string) []*lockWaiter {
+	names := make([]string, 0)
+	for k, v := range s.locks {
+		if claimedBy(v.holder, namespace, groupName, runnerName, stepName) {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	res := make([]*lockWaiter, 0)
+	for _, name := range names {
+		klog.Infof("release lock:%s step:%s runner:%s namespace:%s groupName:%s waiters:%d",
+			name, s.locks[name].holder.StepName, runnerName, namespace, groupName, len(s.locks[name].waiters))
+		res = append(res, s.locks[name].waiters...)
+		delete(s.locks, name)
+	}
+	return res
+}
+
+// dropLockWaiters removes and returns the waiters which matched, the caller must hold the s.mu
+func (s *Scheduler) dropLockWaiters(namespace types.Namespace, groupName types.GroupName, runnerName, stepName string) []*lockWaiter {
+	res := make([]*lockWaiter, 0)
+	for _, l := range s.locks {
+		items := make([]*lockWaiter, 0, len(l.waiters))
+		for _, w := range l.waiters {
+			if claimedBy(w.claim, namespace, groupName, runnerName, stepName) {
+				res = append(res, w)
+				continue
+			}
+			items = append(items, w)
+		}
+		l.waiters = items
+	}
+	return res
+}
+
+// releaseLocks releases the locks which were held by the terminated Step, and their waiters would try to acquire them again
+func (s *Scheduler) releaseLocks(namespace types.Namespace, groupName types.GroupName, runnerName, stepName string) {
+	s.mu.Lock()
+	waiters := s.unlock(namespace, groupName, runnerName, stepName)
+	s.mu.Unlock()
+	s.wakeLockWaiters(waiters)
+}
+
+// wakeLockWaiters runs the waiters in order, the ones whose locks were still held would wait again
+func (s *Scheduler) wakeLockWaiters(waiters []*lockWaiter) {
+	for _, w := range waiters {
+		klog.Infof("wake step:%s runner:%s namespace:%s groupName:%s", w.req.Step.Name, w.req.RunnerName, w.req.Namespace, w.req.GroupName)
+		// the waiting request had passed the approvals, and its Runner was kept busy for it
+		if _, err := s.runStep(w.req, origin{}, false, true); err != nil {
+			klog.V(2).Info(err)
+			g, err2 := s.getGroup(w.req.Namespace, w.req.GroupName)
+			if err2 != nil {
+				klog.V(2).Info(err2)
+				continue
+			}
+			s.mu.Lock()
+			ri, ok := g.Runners[w.req.RunnerName]
+			s.mu.Unlock()
+			if !ok {
+				continue
+			}
+			s.failStep(g, ri, w.req.Step.Name, types.StepReasonError, err.Error())
+		}
+	}
+}
+
+// cancelLockWait reports whether the Step was waiting for a lock, the waiting one would be terminated as StepFailed
+func (s *Scheduler) cancelLockWait(g *Group, ri *types.RunnerInfo, stepName string) bool {
+	s.mu.Lock()
+	if len(s.dropLockWaiters(ri.Namespace, ri.GroupName, ri.Name, stepName)) == 0 {
+		s.mu.Unlock()
+		return false
+	}
+	for i, v := range ri.Steps {
+		if v.Name != stepName {
+			continue
+		}
+		v.Phase = types.StepFailed
+		v.Reason = types.StepReasonCancelled
+		v.Messages = append(v.Messages, types.StepTerminatedMessage(stepName, types.StepReasonCancelled))
+		ri.Steps[i] = v
+		if err := s.updateStepToDashboard(ri.Namespace, ri.GroupName, ri.Name, v.DeepCopy(), origin{}); err != nil {
+			klog.V(2).Info(err)
+		}
+	}
+	s.persistRunner(ri)
+	s.mu.Unlock()
+	klog.Infof("cancel the lock waiting of step:%s runner:%s namespace:%s groupName:%s", stepName, ri.Name, ri.Namespace, ri.GroupName)
+	s.terminateStep(g, ri.Namespace, ri.GroupName, ri.Name, stepName, types.StepFailed)
+	return true
+}
+
+func (s *Scheduler) handleListLocks(data []byte) (res []byte, err error) {
+	req := &types.ListLocksRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	s.mu.Lock()
+	result := &types.ListLocksResponse{
+		Items: make([]types.ResourceLock, 0, len(s.locks)),
+	}
+	for k, v := range s.locks {
+		item := types.ResourceLock{
+			Name:    k,
+			Holder:  v.holder,
+			Waiters: make([]types.LockClaim, 0, len(v.waiters)),
+		}
+		for _, w := range v.waiters {
+			item.Waiters = append(item.Waiters, w.claim)
+		}
+		result.Items = append(result.Items, item)
+	}
+	s.mu.Unlock()
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Name < result.Items[j].Name
+	})
+	return result.Marshal()
+}
@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestScheduler_acquireLocks(t *testing.T) {
+	holder := &types.RunStepRequest{
+		Namespace:  "ns1",
+		GroupName:  "g1",
+		RunnerName: "r1",
+		Step:       types.Step{Name: "upload"},
+	}
+	tests := []struct {
+		name     string
+		req      *types.RunStepRequest
+		locks    []string
+		wantHeld string
+		// wantLocks was the number of the locks after the acquiring
+		wantLocks int
+	}{
+		{
+			name: "TestScheduler_acquireLocks_1",
+			req: &types.RunStepRequest{
+				Namespace:  "ns2",
+				GroupName:  "g2",
+				RunnerName: "r2",
+				Step:       types.Step{Name: "upload"},
+			},
+			locks:     []string{"svn", "ftp"},
+			wantHeld:  "ftp",
+			wantLocks: 1,
+		},
+		{
+			name: "TestScheduler_acquireLocks_2",
+			req: &types.RunStepRequest{
+				Namespace:  "ns2",
+				GroupName:  "g2",
+				RunnerName: "r2",
+				Step:       types.Step{Name: "upload"},
+			},
+			locks:     []string{"svn"},
+			wantLocks: 2,
+		},
+		{
+			name:      "TestScheduler_acquireLocks_3",
+			req:       holder,
+			locks:     []string{"ftp", "svn"},
+			wantLocks: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				locks: make(map[string]*resourceLock, 0),
+			}
+			if got := s.acquireLocks(holder, []string{"ftp"}); got != "" {
+				t.Fatalf("acquireLocks() = %v, want empty", got)
+			}
+			if got := s.acquireLocks(tt.req, tt.locks); got != tt.wantHeld {
+				t.Errorf("acquireLocks() = %v, want %v", got, tt.wantHeld)
+			}
+			if got := len(s.locks); got != tt.wantLocks {
+				t.Errorf("len(locks) = %v, want %v", got, tt.wantLocks)
+			}
+			s.unlock(holder.Namespace, holder.GroupName, holder.RunnerName, "")
+			if _, ok := s.locks["ftp"]; ok {
+				t.Errorf("unlock() the lock ftp was still held")
+			}
+		})
+	}
+}
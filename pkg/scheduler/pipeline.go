@@ -0,0 +1,290 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ErrPipelineWasNotExisted           = "error: namespace:%s groupName:%s pipeline was not existed"
+	ErrPipelineWasRunning              = "error: namespace:%s groupName:%s pipeline was running"
+	ErrPipelineNodeWasDuplicated       = "error: namespace:%s groupName:%s pipeline node:%s was duplicated"
+	ErrPipelineNodeWasInvalid          = "error: namespace:%s groupName:%s pipeline node:%s runner and step were required"
+	ErrPipelineDependencyWasNotExisted = "error: namespace:%s groupName:%s pipeline node:%s dependency:%s was not existed"
+	ErrPipelineHasCycle                = "error: namespace:%s groupName:%s pipeline node:%s was in a cycle"
+)
+
+// newPipeline validates the declared nodes, it returns nil if there wasn't any node
+func newPipeline(namespace types.Namespace, groupName types.GroupName, items []conf.PipelineNode) (*types.Pipeline, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	p := &types.Pipeline{
+		Namespace: namespace,
+		GroupName: groupName,
+		Phase:     types.StepPending,
+		Nodes:     make([]types.PipelineNode, 0, len(items)),
+	}
+	deps := make(map[string][]string, len(items))
+	for _, v := range items {
+		if _, ok := deps[v.Name]; ok || v.Name == "" {
+			return nil, fmt.Errorf(ErrPipelineNodeWasDuplicated, namespace, groupName, v.Name)
+		}
+		if v.Runner == "" || v.Step == "" {
+			return nil, fmt.Errorf(ErrPipelineNodeWasInvalid, namespace, groupName, v.Name)
+		}
+		deps[v.Name] = v.DependsOn
+		p.Nodes = append(p.Nodes, types.PipelineNode{
+			Name:       v.Name,
+			RunnerName: v.Runner,
+			StepName:   v.Step,
+			DependsOn:  v.DependsOn,
+			Phase:      types.StepPending,
+		})
+	}
+	// depth-first search, the visiting nodes were on the current path
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	states := make(map[string]int, len(items))
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch states[name] {
+		case visiting:
+			return fmt.Errorf(ErrPipelineHasCycle, namespace, groupName, name)
+		case visited:
+			return nil
+		}
+		states[name] = visiting
+		for _, v := range deps[name] {
+			if _, ok := deps[v]; !ok {
+				return fmt.Errorf(ErrPipelineDependencyWasNotExisted, namespace, groupName, name, v)
+			}
+			if err := visit(v); err != nil {
+				return err
+			}
+		}
+		states[name] = visited
+		return nil
+	}
+	for _, v := range items {
+		if err := visit(v.Name); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// readyNodes returns the Pending nodes whose dependencies had all succeeded
+func readyNodes(p *types.Pipeline) []types.PipelineNode {
+	phases := make(map[string]types.StepPhase, len(p.Nodes))
+	for _, v := range p.Nodes {
+		phases[v.Name] = v.Phase
+	}
+	res := make([]types.PipelineNode, 0)
+	for _, v := range p.Nodes {
+		if v.Phase != types.StepPending {
+			continue
+		}
+		ready := true
+		for _, d := range v.DependsOn {
+			if phases[d] != types.StepSucceeded {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func (s *Scheduler) handleRunPipeline(data []byte, o origin) (res []byte, err error) {
+	req := &types.RunPipelineRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	var g *Group
+	if g, err = s.getGroup(req.Namespace, req.GroupName); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	if g.pipeline == nil {
+		s.mu.Unlock()
+		return nil, newError(types.CodeNotFound, ErrPipelineWasNotExisted, req.Namespace, req.GroupName)
+	}
+	if g.pipeline.Phase == types.StepRunning {
+		s.mu.Unlock()
+		return nil, newError(types.CodeBusy, ErrPipelineWasRunning, req.Namespace, req.GroupName)
+	}
+	g.pipeline.Phase = types.StepRunning
+	g.pipeline.StartedTM = time.Now().Unix()
+	g.pipeline.Envs = req.Envs
+	for i := range g.pipeline.Nodes {
+		g.pipeline.Nodes[i].Phase = types.StepPending
+	}
+	nodes := readyNodes(g.pipeline)
+	s.mu.Unlock()
+	klog.Infof("handleRunPipeline namespace:%s groupName:%s", req.Namespace, req.GroupName)
+	s.startPipelineNodes(g, nodes, o)
+	result := &types.RunPipelineResponse{}
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleGetPipeline(data []byte) (res []byte, err error) {
+	req := &types.GetPipelineRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	var g *Group
+	if g, err = s.getGroup(req.Namespace, req.GroupName); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	if g.pipeline == nil {
+		s.mu.Unlock()
+		return nil, newError(types.CodeNotFound, ErrPipelineWasNotExisted, req.Namespace, req.GroupName)
+	}
+	result := &types.GetPipelineResponse{
+		Pipeline: *g.pipeline.DeepCopy(),
+	}
+	s.mu.Unlock()
+	return result.Marshal()
+}
+
+// startPipelineNodes marks the nodes as Running and sends them to their Runners
+func (s *Scheduler) startPipelineNodes(g *Group, nodes []types.PipelineNode, o origin) {
+	for _, v := range nodes {
+		s.mu.Lock()
+		if g.pipeline == nil || g.pipeline.Phase != types.StepRunning {
+			s.mu.Unlock()
+			break
+		}
+		setNodePhase(g.pipeline, v.RunnerName, v.StepName, types.StepPending, types.StepRunning)
+		namespace, groupName := g.pipeline.Namespace, g.pipeline.GroupName
+		// the Step was copied with the envs of the pipeline while holding the s.mu
+		ri, ok := g.Runners[v.RunnerName]
+		step := &types.Step{Name: v.StepName}
+		if ok {
+			for _, v2 := range ri.Steps {
+				if v2.Name == v.StepName {
+					step = v2.DeepCopy()
+				}
+			}
+			step.RunnerName = v.RunnerName
+			step.Envs = mergeMap(step.Envs, g.pipeline.Envs)
+		}
+		s.mu.Unlock()
+		err := newError(types.CodeNotFound, ErrRunnerWasNotExisted, namespace, groupName, v.RunnerName)
+		if ok {
+			_, err = s.triggerRunStep(ri, step, o, true)
+		}
+		if err != nil {
+			klog.V(2).Info(err)
+			s.advancePipeline(g, v.RunnerName, v.StepName, types.StepFailed)
+		}
+	}
+	s.pipelineToDashboard(g, o)
+}
+
+// setNodePhase changes the phase of the node from the specific phase, the caller must hold the s.mu
+func setNodePhase(p *types.Pipeline, runnerName, stepName string, from, to types.StepPhase) bool {
+	for i, v := range p.Nodes {
+		if v.RunnerName == runnerName && v.StepName == stepName && v.Phase == from {
+			p.Nodes[i].Phase = to
+			return true
+		}
+	}
+	return false
+}
+
+// advancePipeline records the terminated phase of the Step, and starts the nodes which became ready.
+// The pipeline would be failed as soon as any node didn't succeed, and the Group without the running pipeline would be ignored.
+func (s *Scheduler) advancePipeline(g *Group, runnerName, stepName string, phase types.StepPhase) {
+	s.mu.Lock()
+	if g.pipeline == nil || g.pipeline.Phase != types.StepRunning {
+		s.mu.Unlock()
+		return
+	}
+	if !setNodePhase(g.pipeline, runnerName, stepName, types.StepRunning, phase) {
+		s.mu.Unlock()
+		return
+	}
+	nodes := make([]types.PipelineNode, 0)
+	switch phase {
+	case types.StepSucceeded:
+		nodes = readyNodes(g.pipeline)
+		succeeded := true
+		for _, v := range g.pipeline.Nodes {
+			if v.Phase != types.StepSucceeded {
+				succeeded = false
+			}
+		}
+		if succeeded {
+			g.pipeline.Phase = types.StepSucceeded
+		}
+	default:
+		g.pipeline.Phase = types.StepFailed
+	}
+	finished := g.pipeline.Phase
+	namespace, groupName := g.pipeline.Namespace, g.pipeline.GroupName
+	s.mu.Unlock()
+	if finished != types.StepRunning {
+		s.completeSchedules(namespace, groupName, "", "", finished)
+	}
+	klog.Infof("advancePipeline namespace:%s groupName:%s runner:%s step:%s phase:%s",
+		namespace, groupName, runnerName, stepName, phase)
+	if len(nodes) > 0 {
+		s.startPipelineNodes(g, nodes, origin{})
+		return
+	}
+	s.pipelineToDashboard(g, origin{})
+}
+
+// pipelineToDashboard broadcasts the progress of the pipeline to the dashboards which subscribed the group
+func (s *Scheduler) pipelineToDashboard(g *Group, o origin) {
+	s.mu.Lock()
+	if g.pipeline == nil {
+		s.mu.Unlock()
+		return
+	}
+	data, err := g.pipeline.Marshal()
+	scope := &types.Subscription{
+		Namespace: g.pipeline.Namespace,
+		GroupName: g.pipeline.GroupName,
+	}
+	s.mu.Unlock()
+	if err != nil {
+		klog.V(2).Info(err)
+		return
+	}
+	req := &types.Response{
+		Type: types.Type{
+			ServiceAPI: types.PipelineProgress,
+		},
+		Data:      data,
+		Id:        o.id,
+		Timestamp: o.timestamp,
+		Broadcast: true,
+	}
+	data, err = req.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		return
+	}
+	s.broadcast <- &broadcast{
+		bt:    broadcastTypeDashboard,
+		msg:   data,
+		scope: scope,
+	}
+}
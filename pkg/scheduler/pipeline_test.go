@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func Test_newPipeline(t *testing.T) {
+	tests := []struct {
+		name      string
+		items     []conf.PipelineNode
+		succeeded []string
+		wantReady []string
+		wantErr   bool
+	}{
+		{
+			name: "Test_newPipeline_1",
+			items: []conf.PipelineNode{
+				{Name: "pull", Runner: "packer", Step: "git"},
+				{Name: "build", Runner: "packer", Step: "build", DependsOn: []string{"pull"}},
+				{Name: "upload", Runner: "uploader", Step: "ftp", DependsOn: []string{"build"}},
+				{Name: "commit", Runner: "archiver", Step: "svn", DependsOn: []string{"build"}},
+				{Name: "notify", Runner: "archiver", Step: "robot", DependsOn: []string{"upload", "commit"}},
+			},
+			wantReady: []string{"pull"},
+			wantErr:   false,
+		},
+		{
+			name: "Test_newPipeline_2",
+			items: []conf.PipelineNode{
+				{Name: "pull", Runner: "packer", Step: "git"},
+				{Name: "build", Runner: "packer", Step: "build", DependsOn: []string{"pull"}},
+				{Name: "upload", Runner: "uploader", Step: "ftp", DependsOn: []string{"build"}},
+				{Name: "commit", Runner: "archiver", Step: "svn", DependsOn: []string{"build"}},
+				{Name: "notify", Runner: "archiver", Step: "robot", DependsOn: []string{"upload", "commit"}},
+			},
+			succeeded: []string{"pull", "build", "upload"},
+			wantReady: []string{"commit"},
+			wantErr:   false,
+		},
+		{
+			name: "Test_newPipeline_3",
+			items: []conf.PipelineNode{
+				{Name: "a", Runner: "r1", Step: "git", DependsOn: []string{"c"}},
+				{Name: "b", Runner: "r1", Step: "svn", DependsOn: []string{"a"}},
+				{Name: "c", Runner: "r1", Step: "ftp", DependsOn: []string{"b"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_newPipeline_4",
+			items: []conf.PipelineNode{
+				{Name: "a", Runner: "r1", Step: "git", DependsOn: []string{"missing"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test_newPipeline_5",
+			items: []conf.PipelineNode{
+				{Name: "a", Runner: "r1", Step: "git"},
+				{Name: "a", Runner: "r1", Step: "svn"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newPipeline("ns", "group", tt.items)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newPipeline() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			for _, v := range tt.succeeded {
+				for i := range p.Nodes {
+					if p.Nodes[i].Name == v {
+						p.Nodes[i].Phase = types.StepSucceeded
+					}
+				}
+			}
+			got := make([]string, 0)
+			for _, v := range readyNodes(p) {
+				got = append(got, v.Name)
+			}
+			if !reflect.DeepEqual(got, tt.wantReady) {
+				t.Errorf("readyNodes() = %v, want %v", got, tt.wantReady)
+			}
+		})
+	}
+}
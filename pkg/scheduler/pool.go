@@ -0,0 +1,192 @@
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// GroupModeDefault means that the dashboard must specify the Runner, or the Selector would pick one
+	GroupModeDefault = ""
+	// GroupModePool means that the Runners in the Group were identical, a RunStepRequest without the RunnerName
+	// would be sent to the least-loaded idle Runner, or be queued until one of the Runners was freed.
+	GroupModePool = "pool"
+)
+
+const (
+	ErrNoRunnerWasMatched = "error: namespace:%s groupName:%s no runner matched selector:%s step:%s"
+	ErrRunnerWasNotOwned  = "error: namespace:%s groupName:%s runner:%s was not kept busy by step:%s attempt:%d"
+)
+
+// addRunner tracks the busy state of the registered Runner, the caller must hold the s.mu
+func (g *Group) addRunner(ri *types.RunnerInfo) {
+	r := NewRunner(context.Background(), ri)
+	if v := runningStep(ri); v != nil {
+		r.status = Running
+		r.step, r.attempt = v.Name, v.Attempt
+	}
+	g.pool[ri.Name] = r
+}
+
+// deleteRunner the caller must hold the s.mu
+func (g *Group) deleteRunner(name string) {
+	if r, ok := g.pool[name]; ok {
+		r.cancel()
+		delete(g.pool, name)
+	}
+}
+
+// pickRunner returns the least-loaded idle Runner which matches the selector and offers the Step,
+// and marks it as Running. The matched would be false if none of the Runners matched whether they were busy or not.
+// The caller must hold the s.mu
+func (g *Group) pickRunner(sel Selector, stepName string) (name string, matched bool) {
+	names := make([]string, 0, len(g.Runners))
+	for k := range g.Runners {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var picked *Runner
+	for _, k := range names {
+		ri := g.Runners[k]
+		if !sel.Matches(ri.Labels) || !hasStep(ri, stepName) {
+			continue
+		}
+		matched = true
+		r, ok := g.pool[k]
+		if !ok || r.status != Idle {
+			continue
+		}
+		if picked == nil || r.load < picked.load {
+			picked = r
+			name = k
+		}
+	}
+	if picked != nil {
+		g.markRunning(name, stepName, 0)
+	}
+	return name, matched
+}
+
+// markRunning keeps the Runner busy for the Step, the Runner which had been kept busy for it would be handed over to the Step.
+// The caller must hold the s.mu
+func (g *Group) markRunning(name, stepName string, attempt int32) {
+	r, ok := g.pool[name]
+	if !ok {
+		return
+	}
+	if r.status == Idle {
+		r.status = Running
+		r.load++
+	}
+	r.step, r.attempt = stepName, attempt
+}
+
+// handOver keeps the Runner busy for the next Step which would be triggered after the Step at the attempt,
+// it reports false if the Runner had been kept busy by the others. The caller must hold the s.mu
+func (g *Group) handOver(name, stepName string, attempt int32, next string) bool {
+	r, ok := g.pool[name]
+	if !ok {
+		return true
+	}
+	if r.status == Running && !r.ownedBy(stepName, attempt) {
+		return false
+	}
+	g.markRunning(name, next, 0)
+	return true
+}
+
+// selectRunner picks a Runner for the RunStepRequest which didn't specify the RunnerName.
+// The queued would be the QueuedStep if all the matched Runners were busy in the GroupModePool,
+// and the request would be sent after one of them was released.
+func (s *Scheduler) selectRunner(g *Group, req *types.RunStepRequest) (name string, queued *types.QueuedStep, err error) {
+	sel, err := ParseSelector(req.Selector)
+	if err != nil {
+		return "", nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name, matched := g.pickRunner(sel, req.Step.Name)
+	if name != "" {
+		return name, nil, nil
+	}
+	if !matched {
+		return "", nil, newError(types.CodeNotFound, ErrNoRunnerWasMatched, req.Namespace, req.GroupName, req.Selector, req.Step.Name)
+	}
+	if g.Mode != GroupModePool {
+		return "", nil, newError(types.CodeBusy, ErrNoIdleRunnerWasMatched, req.Namespace, req.GroupName, req.Selector, req.Step.Name)
+	}
+	g.queue = append(g.queue, req.DeepCopy())
+	klog.Infof("queue step:%s namespace:%s groupName:%s queued:%d", req.Step.Name, req.Namespace, req.GroupName, len(g.queue))
+	queued = &types.QueuedStep{
+		Position: int32(len(g.queue) - 1),
+		Request:  *req.DeepCopy(),
+		QueuedTM: time.Now().UnixNano() / int64(time.Millisecond),
+	}
+	return "", queued, nil
+}
+
+// releaseRunner marks the Runner which was kept busy by the Step at the attempt as Idle, and sends the first request
+// in the queue of the Runner to it. The releases of the other Steps would be ignored, such as the late one of the expired Step.
+// If the queue of the Runner was empty, the first queued request of the Group which could be run would be sent to an idle Runner
+func (s *Scheduler) releaseRunner(namespace types.Namespace, groupName types.GroupName, runnerName, stepName string, attempt int32) {
+	g, err := s.getGroup(namespace, groupName)
+	if err != nil {
+		klog.V(2).Info(err)
+		return
+	}
+	s.mu.Lock()
+	if r, ok := g.pool[runnerName]; ok {
+		if !r.ownedBy(stepName, attempt) {
+			s.mu.Unlock()
+			klog.V(2).Infof(ErrRunnerWasNotOwned, namespace, groupName, runnerName, stepName, attempt)
+			return
+		}
+		r.status = Idle
+		r.step, r.attempt = "", 0
+	}
+	next := g.dequeueStep(runnerName)
+	approved := next != nil
+	if next != nil {
+		g.markRunning(runnerName, next.Step.Name, 0)
+		s.queueToDashboard(g, namespace, groupName, runnerName, origin{})
+	}
+	for i, v := range g.queue {
+		if next != nil {
+			break
+		}
+		sel, err := ParseSelector(v.Selector)
+		if err != nil {
+			continue
+		}
+		if name, _ := g.pickRunner(sel, v.Step.Name); name != "" {
+			next = v
+			next.RunnerName = name
+			next.Step.RunnerName = name
+			g.queue = append(g.queue[:i], g.queue[i+1:]...)
+			break
+		}
+	}
+	s.mu.Unlock()
+	if next == nil {
+		return
+	}
+	klog.Infof("dequeue step:%s runner:%s namespace:%s groupName:%s", next.Step.Name, next.RunnerName, namespace, groupName)
+	// the requests in the queue of the Runner had been approved before they were queued
+	if _, err = s.runStep(next, origin{}, false, approved); err != nil {
+		klog.V(2).Info(err)
+		s.releaseRunner(namespace, groupName, next.RunnerName, next.Step.Name, 0)
+	}
+}
+
+// isTerminated reports whether the Step wouldn't be changed by the Runner anymore
+func isTerminated(phase types.StepPhase) bool {
+	switch phase {
+	case types.StepSucceeded, types.StepFailed, types.StepUnknown:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestGroup_pickRunner(t *testing.T) {
+	newGroup := func() *Group {
+		g := &Group{
+			Runners: make(map[string]*types.RunnerInfo, 0),
+			Ids:     make(map[int32]string, 0),
+			Mode:    GroupModePool,
+			pool:    make(map[string]*Runner, 0),
+		}
+		for _, name := range []string{"r1", "r2", "r3"} {
+			ri := &types.RunnerInfo{
+				Name:   name,
+				Labels: map[string]string{"disk": "ssd"},
+				Steps:  []types.Step{{Name: "build"}},
+			}
+			if name == "r3" {
+				ri.Labels = map[string]string{"disk": "hdd"}
+			}
+			g.Runners[name] = ri
+			g.addRunner(ri)
+		}
+		return g
+	}
+	tests := []struct {
+		name        string
+		busy        []string
+		loads       map[string]int32
+		selector    string
+		stepName    string
+		wantName    string
+		wantMatched bool
+	}{
+		{
+			name:        "TestGroup_pickRunner_1",
+			loads:       map[string]int32{"r1": 2, "r2": 1, "r3": 3},
+			stepName:    "build",
+			wantName:    "r2",
+			wantMatched: true,
+		},
+		{
+			name:        "TestGroup_pickRunner_2",
+			busy:        []string{"r2"},
+			loads:       map[string]int32{"r1": 2, "r2": 1, "r3": 0},
+			selector:    "disk=ssd",
+			stepName:    "build",
+			wantName:    "r1",
+			wantMatched: true,
+		},
+		{
+			name:        "TestGroup_pickRunner_3",
+			busy:        []string{"r1", "r2"},
+			selector:    "disk=ssd",
+			stepName:    "build",
+			wantName:    "",
+			wantMatched: true,
+		},
+		{
+			name:        "TestGroup_pickRunner_4",
+			stepName:    "deploy",
+			wantName:    "",
+			wantMatched: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newGroup()
+			for _, v := range tt.busy {
+				g.markRunning(v, "build", 1)
+			}
+			for k, v := range tt.loads {
+				g.pool[k].load = v
+			}
+			sel, err := ParseSelector(tt.selector)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotName, gotMatched := g.pickRunner(sel, tt.stepName)
+			if gotName != tt.wantName {
+				t.Errorf("pickRunner() gotName = %v, want %v", gotName, tt.wantName)
+			}
+			if gotMatched != tt.wantMatched {
+				t.Errorf("pickRunner() gotMatched = %v, want %v", gotMatched, tt.wantMatched)
+			}
+			if gotName != "" && g.pool[gotName].status != Running {
+				t.Errorf("pickRunner() runner:%s status = %v, want %v", gotName, g.pool[gotName].status, Running)
+			}
+		})
+	}
+}
+
+func TestScheduler_releaseRunner(t *testing.T) {
+	tests := []struct {
+		name       string
+		stepName   string
+		attempt    int32
+		next       bool
+		wantStatus RunnerStatus
+		wantStep   string
+	}{
+		{
+			name:       "TestScheduler_releaseRunner_1",
+			stepName:   "build",
+			attempt:    2,
+			wantStatus: Idle,
+		},
+		{
+			name:       "TestScheduler_releaseRunner_2",
+			stepName:   "build",
+			attempt:    1,
+			wantStatus: Running,
+			wantStep:   "build",
+		},
+		{
+			name:       "TestScheduler_releaseRunner_3",
+			stepName:   "upload",
+			attempt:    2,
+			wantStatus: Running,
+			wantStep:   "build",
+		},
+		{
+			name:       "TestScheduler_releaseRunner_4",
+			stepName:   "build",
+			attempt:    0,
+			wantStatus: Idle,
+		},
+		{
+			// the late release of the expired Step wouldn't release the Runner which was running the next Step
+			name:       "TestScheduler_releaseRunner_5",
+			stepName:   "build",
+			attempt:    2,
+			next:       true,
+			wantStatus: Running,
+			wantStep:   "upload",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				items: map[types.Namespace]*Groups{"ns1": newGroups(true)},
+			}
+			g := newGroup(GroupModePool, "", true, nil, nil)
+			s.items["ns1"].items["g1"] = g
+			ri := &types.RunnerInfo{
+				Name:  "r1",
+				Steps: []types.Step{{Name: "build", Phase: types.StepRunning, Attempt: 2}, {Name: "upload"}},
+			}
+			g.Runners[ri.Name] = ri
+			g.addRunner(ri)
+			s.releaseRunner("ns1", "g1", ri.Name, tt.stepName, tt.attempt)
+			if tt.next {
+				g.markRunning(ri.Name, "upload", 1)
+				s.releaseRunner("ns1", "g1", ri.Name, tt.stepName, tt.attempt)
+			}
+			r := g.pool[ri.Name]
+			if r.status != tt.wantStatus || r.step != tt.wantStep {
+				t.Errorf("releaseRunner() status = %v step = %v, want %v %v", r.status, r.step, tt.wantStatus, tt.wantStep)
+			}
+		})
+	}
+}
@@ -0,0 +1,484 @@
+package scheduler
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// PermissionAllNamespaces permits the token to manage all the namespaces
+	PermissionAllNamespaces = "*"
+)
+
+const (
+	ErrNamespaceWasExisted   = "error: namespace:%s was existed"
+	ErrGroupWasExisted       = "error: namespace:%s groupName:%s was existed"
+	ErrNamespaceWasDeclared  = "error: namespace:%s was declared in the configuration"
+	ErrGroupWasDeclared      = "error: namespace:%s groupName:%s was declared in the configuration"
+	ErrGroupWasInUse         = "error: namespace:%s groupName:%s was in use by %d runners and %d schedules"
+	ErrProjectNameWasInvalid = "error: name:%q was invalid"
+	ErrGroupModeWasInvalid   = "error: mode:%s was invalid"
+	ErrProjectWasForbidden   = "error: the token was not permitted to manage namespace:%s"
+	ErrRunnerWasPending      = "error: namespace:%s groupName:%s was not existed, runner:%s was pending until it was created"
+)
+
+func newGroups(declared bool) *Groups {
+	return &Groups{
+		items:    make(map[types.GroupName]*Group, 0),
+		declared: declared,
+	}
+}
+
+// newGroup returns the Group which was declared in the configuration,
+// the Group which was created at runtime had neither the pipeline nor the hooks.
+func newGroup(mode, duplicate string, declared bool, pipeline *types.Pipeline, hooks map[string]*conf.Hook) *Group {
+	return &Group{
+		Runners:   make(map[string]*types.RunnerInfo, 0),
+		Ids:       make(map[int32]string, 0),
+		sessions:  make(map[string][]int32, 0),
+		Mode:      mode,
+		duplicate: duplicate,
+		pool:      make(map[string]*Runner, 0),
+		queue:     make([]*types.RunStepRequest, 0),
+		runQueues: make(map[string][]*types.QueuedStep, 0),
+		approvals: make(map[string]int32, 0),
+		gates:     make(map[string]*gate, 0),
+		pipeline:  pipeline,
+		hooks:     hooks,
+		declared:  declared,
+	}
+}
+
+// loadProjects restores the namespaces and the groups which were created at runtime.
+// The groups which were declared in the configuration at the same time would be kept as declared.
+func (s *Scheduler) loadProjects() {
+	rows, err := s.dao.ListProjects()
+	if err != nil {
+		klog.V(2).Info(err)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range rows {
+		namespace, groupName := types.Namespace(v.Namespace), types.GroupName(v.GroupName)
+		t, ok := s.items[namespace]
+		if !ok {
+			t = newGroups(false)
+			s.items[namespace] = t
+		}
+		if groupName == "" {
+			continue
+		}
+		if _, ok := t.items[groupName]; ok {
+			continue
+		}
+		t.items[groupName] = newGroup(v.Mode, v.Duplicate, false, nil, nil)
+	}
+}
+
+// validProjectName reports whether the name could be a namespace or a group, it would be a part of the paths
+func validProjectName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, "/ ")
+}
+
+// authorize returns an error if the token was not permitted to manage any of the namespaces
+func (s *Scheduler) authorize(token string, namespaces ...types.Namespace) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, ns := range namespaces {
+		permitted := false
+		for _, p := range s.permissions {
+			if token == "" || p.Token != token {
+				continue
+			}
+			for _, v := range p.Namespaces {
+				if v == PermissionAllNamespaces || v == string(ns) {
+					permitted = true
+				}
+			}
+		}
+		if !permitted {
+			return newError(types.CodeForbidden, ErrProjectWasForbidden, ns)
+		}
+	}
+	return nil
+}
+
+// checkUnused returns an error if any of the groups had the Runners, the queued requests or the Schedules
+func (s *Scheduler) checkUnused(namespace types.Namespace, groupNames ...types.GroupName) error {
+	for _, groupName := range groupNames {
+		schedules := 0
+		s.schedules.mu.Lock()
+		for _, e := range s.schedules.items {
+			if e.schedule.Namespace == namespace && e.schedule.GroupName == groupName {
+				schedules++
+			}
+		}
+		s.schedules.mu.Unlock()
+		runners := 0
+		s.mu.Lock()
+		if g, err := s.group(namespace, groupName); err == nil {
+			runners = len(g.Runners) + len(g.queue)
+		}
+		s.mu.Unlock()
+		if runners > 0 || schedules > 0 {
+			return newError(types.CodeBusy, ErrGroupWasInUse, namespace, groupName, runners, schedules)
+		}
+	}
+	return nil
+}
+
+// broadcastToDashboards syncs the Data of the ServiceAPI to all dashboards, such as the succeeded requests and the events
+func (s *Scheduler) broadcastToDashboards(api types.ServiceAPI, data []byte, o origin) error {
+	return s.broadcastToSubscribers(api, data, nil, o)
+}
+
+// broadcastToSubscribers syncs the Data of the ServiceAPI to the dashboards which subscribed the scope
+func (s *Scheduler) broadcastToSubscribers(api types.ServiceAPI, data []byte, scope *types.Subscription, o origin) error {
+	res := &types.Response{
+		Type: types.Type{
+			ServiceAPI: api,
+		},
+		Data:      data,
+		Id:        o.id,
+		Timestamp: o.timestamp,
+		Broadcast: true,
+	}
+	msg, err := res.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	s.broadcast <- &broadcast{
+		bt:    broadcastTypeDashboard,
+		msg:   msg,
+		scope: scope,
+	}
+	return nil
+}
+
+// adoptRunners registers the pending Runners whose groups had been created or renamed,
+// or whose duplicates had disconnected
+func (s *Scheduler) adoptRunners() {
+	s.mu.Lock()
+	adopted := make(map[int32]*types.RunnerInfo, 0)
+	for id, ri := range s.pending {
+		if s.adoptable(ri) {
+			adopted[id] = ri
+			delete(s.pending, id)
+		}
+	}
+	s.mu.Unlock()
+	for id, ri := range adopted {
+		if err := s.registerRunner(ri, id); err != nil {
+			klog.V(2).Info(err)
+			continue
+		}
+		// the Runner was answered with the error when it was parked
+		res := &types.Response{
+			Code: types.CodeOK,
+			Type: types.Type{
+				ServiceAPI: types.RegisterRunner,
+			},
+			Broadcast: true,
+		}
+		msg, err := res.Marshal()
+		if err != nil {
+			klog.V(2).Info(err)
+			continue
+		}
+		s.broadcast <- &broadcast{
+			bt:       broadcastTypeRunner,
+			clientId: id,
+			msg:      msg,
+		}
+		klog.Infof("adopt pending runner:%s namespace:%s groupName:%s", ri.Name, ri.Namespace, ri.GroupName)
+	}
+}
+
+func (s *Scheduler) handleCreateNamespace(data []byte, token string, o origin) (res []byte, err error) {
+	req := &types.CreateNamespaceRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	if !validProjectName(string(req.Namespace)) {
+		return nil, newError(types.CodeInvalid, ErrProjectNameWasInvalid, req.Namespace)
+	}
+	if err = s.authorize(token, req.Namespace); err != nil {
+		return nil, err
+	}
+	s.projectMu.Lock()
+	defer s.projectMu.Unlock()
+	s.mu.Lock()
+	_, ok := s.items[req.Namespace]
+	s.mu.Unlock()
+	if ok {
+		return nil, newError(types.CodeBusy, ErrNamespaceWasExisted, req.Namespace)
+	}
+	if err = s.dao.InsertProject(string(req.Namespace), "", "", ""); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	s.items[req.Namespace] = newGroups(false)
+	s.mu.Unlock()
+	if err = s.broadcastToDashboards(types.CreateNamespace, data, o); err != nil {
+		return nil, err
+	}
+	result := &types.CreateNamespaceResponse{}
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleRenameNamespace(data []byte, token string, o origin) (res []byte, err error) {
+	req := &types.RenameNamespaceRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	if !validProjectName(string(req.NewName)) {
+		return nil, newError(types.CodeInvalid, ErrProjectNameWasInvalid, req.NewName)
+	}
+	if err = s.authorize(token, req.Namespace, req.NewName); err != nil {
+		return nil, err
+	}
+	s.projectMu.Lock()
+	defer s.projectMu.Unlock()
+	groupNames, err := s.runtimeGroupNames(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	_, ok := s.items[req.NewName]
+	s.mu.Unlock()
+	if ok {
+		return nil, newError(types.CodeBusy, ErrNamespaceWasExisted, req.NewName)
+	}
+	if err = s.checkUnused(req.Namespace, groupNames...); err != nil {
+		return nil, err
+	}
+	if err = s.dao.RenameProjectNamespace(string(req.Namespace), string(req.NewName)); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	s.items[req.NewName] = s.items[req.Namespace]
+	delete(s.items, req.Namespace)
+	s.mu.Unlock()
+	s.adoptRunners()
+	if err = s.broadcastToDashboards(types.RenameNamespace, data, o); err != nil {
+		return nil, err
+	}
+	result := &types.RenameNamespaceResponse{}
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleDeleteNamespace(data []byte, token string, o origin) (res []byte, err error) {
+	req := &types.DeleteNamespaceRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	if err = s.authorize(token, req.Namespace); err != nil {
+		return nil, err
+	}
+	s.projectMu.Lock()
+	defer s.projectMu.Unlock()
+	groupNames, err := s.runtimeGroupNames(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkUnused(req.Namespace, groupNames...); err != nil {
+		return nil, err
+	}
+	if err = s.dao.DeleteProjectNamespace(string(req.Namespace)); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	delete(s.items, req.Namespace)
+	s.mu.Unlock()
+	if err = s.broadcastToDashboards(types.DeleteNamespace, data, o); err != nil {
+		return nil, err
+	}
+	result := &types.DeleteNamespaceResponse{}
+	return result.Marshal()
+}
+
+// runtimeGroupNames returns the groups of the namespace which was created at runtime
+func (s *Scheduler) runtimeGroupNames(namespace types.Namespace) ([]types.GroupName, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.items[namespace]
+	if !ok {
+		return nil, newError(types.CodeNotFound, ErrNamespaceWasNotExisted, namespace)
+	}
+	if t.declared {
+		return nil, newError(types.CodeForbidden, ErrNamespaceWasDeclared, namespace)
+	}
+	res := make([]types.GroupName, 0, len(t.items))
+	for k := range t.items {
+		res = append(res, k)
+	}
+	return res, nil
+}
+
+func (s *Scheduler) handleCreateGroup(data []byte, token string, o origin) (res []byte, err error) {
+	req := &types.CreateGroupRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	if !validProjectName(string(req.GroupName)) {
+		return nil, newError(types.CodeInvalid, ErrProjectNameWasInvalid, req.GroupName)
+	}
+	if req.Mode != GroupModeDefault && req.Mode != GroupModePool {
+		return nil, newError(types.CodeInvalid, ErrGroupModeWasInvalid, req.Mode)
+	}
+	if !validDuplicatePolicy(req.Duplicate) {
+		return nil, newError(types.CodeInvalid, ErrDuplicatePolicyWasInvalid, req.Duplicate)
+	}
+	if err = s.authorize(token, req.Namespace); err != nil {
+		return nil, err
+	}
+	s.projectMu.Lock()
+	defer s.projectMu.Unlock()
+	s.mu.Lock()
+	t, ok := s.items[req.Namespace]
+	if !ok {
+		s.mu.Unlock()
+		return nil, newError(types.CodeNotFound, ErrNamespaceWasNotExisted, req.Namespace)
+	}
+	_, ok = t.items[req.GroupName]
+	s.mu.Unlock()
+	if ok {
+		return nil, newError(types.CodeBusy, ErrGroupWasExisted, req.Namespace, req.GroupName)
+	}
+	if err = s.dao.InsertProject(string(req.Namespace), string(req.GroupName), req.Mode, req.Duplicate); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	t.items[req.GroupName] = newGroup(req.Mode, req.Duplicate, false, nil, nil)
+	s.mu.Unlock()
+	s.adoptRunners()
+	if err = s.broadcastToDashboards(types.CreateGroup, data, o); err != nil {
+		return nil, err
+	}
+	result := &types.CreateGroupResponse{}
+	return result.Marshal()
+}
+
+// runtimeGroup returns an error if the group was not existed or declared in the configuration
+func (s *Scheduler) runtimeGroup(namespace types.Namespace, groupName types.GroupName) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, err := s.group(namespace, groupName)
+	if err != nil {
+		return err
+	}
+	if g.declared {
+		return newError(types.CodeForbidden, ErrGroupWasDeclared, namespace, groupName)
+	}
+	return nil
+}
+
+func (s *Scheduler) handleRenameGroup(data []byte, token string, o origin) (res []byte, err error) {
+	req := &types.RenameGroupRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	if !validProjectName(string(req.NewName)) {
+		return nil, newError(types.CodeInvalid, ErrProjectNameWasInvalid, req.NewName)
+	}
+	if err = s.authorize(token, req.Namespace); err != nil {
+		return nil, err
+	}
+	s.projectMu.Lock()
+	defer s.projectMu.Unlock()
+	if err = s.runtimeGroup(req.Namespace, req.GroupName); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	_, err = s.group(req.Namespace, req.NewName)
+	s.mu.Unlock()
+	if err == nil {
+		return nil, newError(types.CodeBusy, ErrGroupWasExisted, req.Namespace, req.NewName)
+	}
+	if err = s.checkUnused(req.Namespace, req.GroupName); err != nil {
+		return nil, err
+	}
+	if err = s.dao.RenameProjectGroup(string(req.Namespace), string(req.GroupName), string(req.NewName)); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	t := s.items[req.Namespace]
+	t.items[req.NewName] = t.items[req.GroupName]
+	delete(t.items, req.GroupName)
+	s.mu.Unlock()
+	s.adoptRunners()
+	if err = s.broadcastToDashboards(types.RenameGroup, data, o); err != nil {
+		return nil, err
+	}
+	result := &types.RenameGroupResponse{}
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleDeleteGroup(data []byte, token string, o origin) (res []byte, err error) {
+	req := &types.DeleteGroupRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	if err = s.authorize(token, req.Namespace); err != nil {
+		return nil, err
+	}
+	s.projectMu.Lock()
+	defer s.projectMu.Unlock()
+	if err = s.runtimeGroup(req.Namespace, req.GroupName); err != nil {
+		return nil, err
+	}
+	if err = s.checkUnused(req.Namespace, req.GroupName); err != nil {
+		return nil, err
+	}
+	if err = s.dao.DeleteProjectGroup(string(req.Namespace), string(req.GroupName)); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	delete(s.items[req.Namespace].items, req.GroupName)
+	s.mu.Unlock()
+	if err = s.broadcastToDashboards(types.DeleteGroup, data, o); err != nil {
+		return nil, err
+	}
+	result := &types.DeleteGroupResponse{}
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleListPendingRunners(data []byte) (res []byte, err error) {
+	result := &types.ListPendingRunnersResponse{
+		Runners: make([]types.RunnerInfo, 0),
+	}
+	s.mu.Lock()
+	for _, v := range s.pending {
+		result.Runners = append(result.Runners, *v.DeepCopy())
+	}
+	s.mu.Unlock()
+	sort.Slice(result.Runners, func(i, j int) bool {
+		a, b := result.Runners[i], result.Runners[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.GroupName != b.GroupName {
+			return a.GroupName < b.GroupName
+		}
+		return a.Name < b.Name
+	})
+	return result.Marshal()
+}
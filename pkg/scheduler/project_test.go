@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestScheduler_authorize(t *testing.T) {
+	s := &Scheduler{
+		permissions: []conf.Permission{
+			{Token: "admin", Namespaces: []string{PermissionAllNamespaces}},
+			{Token: "ns1-admin", Namespaces: []string{"ns1"}},
+			{Token: "", Namespaces: []string{PermissionAllNamespaces}},
+		},
+	}
+	tests := []struct {
+		name       string
+		token      string
+		namespaces []types.Namespace
+		wantCode   int32
+	}{
+		{
+			name:       "TestScheduler_authorize_1",
+			token:      "admin",
+			namespaces: []types.Namespace{"ns1", "ns2"},
+			wantCode:   types.CodeOK,
+		},
+		{
+			name:       "TestScheduler_authorize_2",
+			token:      "ns1-admin",
+			namespaces: []types.Namespace{"ns1"},
+			wantCode:   types.CodeOK,
+		},
+		{
+			name:       "TestScheduler_authorize_3",
+			token:      "ns1-admin",
+			namespaces: []types.Namespace{"ns1", "ns2"},
+			wantCode:   types.CodeForbidden,
+		},
+		{
+			name:       "TestScheduler_authorize_4",
+			token:      "",
+			namespaces: []types.Namespace{"ns1"},
+			wantCode:   types.CodeForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.authorize(tt.token, tt.namespaces...)
+			if tt.wantCode == types.CodeOK {
+				if err != nil {
+					t.Errorf("authorize() error = %v", err)
+				}
+				return
+			}
+			if code := errorCode(err); err == nil || code != tt.wantCode {
+				t.Errorf("authorize() error = %v, wantCode %v", err, tt.wantCode)
+			}
+		})
+	}
+}
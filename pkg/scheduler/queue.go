@@ -0,0 +1,179 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ErrQueuedStepWasNotExisted = "error: namespace:%s groupName:%s runner:%s queued step id:%d was not existed"
+)
+
+// enqueueStep appends the request to the queue of its Runner, the caller must hold the s.mu
+func (g *Group) enqueueStep(req *types.RunStepRequest) types.QueuedStep {
+	g.queueSeq++
+	q := &types.QueuedStep{
+		Id:       g.queueSeq,
+		Request:  *req.DeepCopy(),
+		QueuedTM: time.Now().UnixNano() / int64(time.Millisecond),
+	}
+	g.runQueues[req.RunnerName] = append(g.runQueues[req.RunnerName], q)
+	q.Position = int32(len(g.runQueues[req.RunnerName]) - 1)
+	return *q
+}
+
+// dequeueStep removes and returns the first request in the queue of the Runner, the caller must hold the s.mu
+func (g *Group) dequeueStep(runnerName string) *types.RunStepRequest {
+	items := g.runQueues[runnerName]
+	if len(items) == 0 {
+		return nil
+	}
+	if len(items) == 1 {
+		delete(g.runQueues, runnerName)
+	} else {
+		g.runQueues[runnerName] = items[1:]
+	}
+	return items[0].Request.DeepCopy()
+}
+
+// cancelQueuedStep reports whether the request was found and removed, the caller must hold the s.mu
+func (g *Group) cancelQueuedStep(runnerName string, id int64) bool {
+	items := g.runQueues[runnerName]
+	for i, v := range items {
+		if v.Id != id {
+			continue
+		}
+		items = append(items[:i:i], items[i+1:]...)
+		if len(items) == 0 {
+			delete(g.runQueues, runnerName)
+		} else {
+			g.runQueues[runnerName] = items
+		}
+		return true
+	}
+	return false
+}
+
+// moveQueuedStep moves the request to the position, the position which was out of range would move it to the end.
+// It reports whether the request was found, the caller must hold the s.mu
+func (g *Group) moveQueuedStep(runnerName string, id int64, position int32) bool {
+	items := g.runQueues[runnerName]
+	for i, v := range items {
+		if v.Id != id {
+			continue
+		}
+		items = append(items[:i:i], items[i+1:]...)
+		if position < 0 || int(position) > len(items) {
+			position = int32(len(items))
+		}
+		items = append(items[:position:position], append([]*types.QueuedStep{v}, items[position:]...)...)
+		g.runQueues[runnerName] = items
+		return true
+	}
+	return false
+}
+
+// queuedSteps returns the copies of the queued requests of the Runner with their positions, the caller must hold the s.mu
+func (g *Group) queuedSteps(runnerName string) []types.QueuedStep {
+	items := make([]types.QueuedStep, 0, len(g.runQueues[runnerName]))
+	for i, v := range g.runQueues[runnerName] {
+		q := *v.DeepCopy()
+		q.Position = int32(i)
+		items = append(items, q)
+	}
+	return items
+}
+
+// queueToDashboard syncs the queue of the Runner to all dashboards, the caller must hold the s.mu
+func (s *Scheduler) queueToDashboard(g *Group, namespace types.Namespace, groupName types.GroupName, runnerName string, o origin) {
+	rq := &types.RunnerQueueEvent{
+		Namespace:  namespace,
+		GroupName:  groupName,
+		RunnerName: runnerName,
+		Items:      g.queuedSteps(runnerName),
+	}
+	data, err := rq.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		return
+	}
+	scope := &types.Subscription{
+		Namespace:  namespace,
+		GroupName:  groupName,
+		RunnerName: runnerName,
+	}
+	if err = s.broadcastToSubscribers(types.RunnerQueue, data, scope, o); err != nil {
+		klog.V(2).Info(err)
+	}
+}
+
+func (s *Scheduler) handleListRunnerQueue(data []byte) (res []byte, err error) {
+	req := &types.ListRunnerQueueRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	s.mu.Lock()
+	g, err := s.group(req.Namespace, req.GroupName)
+	if err != nil {
+		s.mu.Unlock()
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	result := &types.ListRunnerQueueResponse{
+		Items: g.queuedSteps(req.RunnerName),
+	}
+	s.mu.Unlock()
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleCancelQueuedStep(data []byte, o origin) (res []byte, err error) {
+	req := &types.CancelQueuedStepRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, err := s.group(req.Namespace, req.GroupName)
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	if !g.cancelQueuedStep(req.RunnerName, req.Id) {
+		err = newError(types.CodeNotFound, ErrQueuedStepWasNotExisted, req.Namespace, req.GroupName, req.RunnerName, req.Id)
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	klog.Infof("cancel queued step id:%d runner:%s namespace:%s groupName:%s", req.Id, req.RunnerName, req.Namespace, req.GroupName)
+	s.queueToDashboard(g, req.Namespace, req.GroupName, req.RunnerName, o)
+	result := &types.CancelQueuedStepResponse{}
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleMoveQueuedStep(data []byte, o origin) (res []byte, err error) {
+	req := &types.MoveQueuedStepRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, err := s.group(req.Namespace, req.GroupName)
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	if !g.moveQueuedStep(req.RunnerName, req.Id, req.Position) {
+		err = newError(types.CodeNotFound, ErrQueuedStepWasNotExisted, req.Namespace, req.GroupName, req.RunnerName, req.Id)
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.queueToDashboard(g, req.Namespace, req.GroupName, req.RunnerName, o)
+	result := &types.MoveQueuedStepResponse{
+		Items: g.queuedSteps(req.RunnerName),
+	}
+	return result.Marshal()
+}
@@ -0,0 +1,82 @@
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestGroup_moveQueuedStep(t *testing.T) {
+	newGroup := func() *Group {
+		g := newGroup(GroupModeDefault, "", true, nil, nil)
+		for _, name := range []string{"a", "b", "c"} {
+			g.enqueueStep(&types.RunStepRequest{RunnerName: "r1", Step: types.Step{Name: name}})
+		}
+		return g
+	}
+	tests := []struct {
+		name     string
+		id       int64
+		position int32
+		cancel   bool
+		want     []string
+		wantOK   bool
+	}{
+		{
+			name:     "TestGroup_moveQueuedStep_1",
+			id:       3,
+			position: 0,
+			want:     []string{"c", "a", "b"},
+			wantOK:   true,
+		},
+		{
+			name:     "TestGroup_moveQueuedStep_2",
+			id:       1,
+			position: 10,
+			want:     []string{"b", "c", "a"},
+			wantOK:   true,
+		},
+		{
+			name:     "TestGroup_moveQueuedStep_3",
+			id:       4,
+			position: 0,
+			want:     []string{"a", "b", "c"},
+			wantOK:   false,
+		},
+		{
+			name:   "TestGroup_moveQueuedStep_4",
+			id:     2,
+			cancel: true,
+			want:   []string{"a", "c"},
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newGroup()
+			var ok bool
+			if tt.cancel {
+				ok = g.cancelQueuedStep("r1", tt.id)
+			} else {
+				ok = g.moveQueuedStep("r1", tt.id, tt.position)
+			}
+			if ok != tt.wantOK {
+				t.Errorf("moveQueuedStep() ok = %v, want %v", ok, tt.wantOK)
+			}
+			got := make([]string, 0)
+			for i, v := range g.queuedSteps("r1") {
+				if v.Position != int32(i) {
+					t.Errorf("queuedSteps() position = %v, want %v", v.Position, i)
+				}
+				got = append(got, v.Request.Step.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("queuedSteps() = %v, want %v", got, tt.want)
+			}
+			if next := g.dequeueStep("r1"); next == nil || next.Step.Name != tt.want[0] {
+				t.Errorf("dequeueStep() = %v, want %v", next, tt.want[0])
+			}
+		})
+	}
+}
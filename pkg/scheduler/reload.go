@@ -0,0 +1,170 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/conf"
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// RetireCheckInterval was the interval of removing the retired groups which had become idle
+	RetireCheckInterval = time.Second * 10
+)
+
+const (
+	ErrGroupWasRetired = "error: namespace:%s groupName:%s was removed from the configuration"
+)
+
+// Reload applies the changed configuration without restarting the http server or dropping the connections.
+// The PublisherService and the Mysql couldn't be changed until the Server was restarted.
+func (s *Server) Reload(c *conf.Config) {
+	if err := s.connections.scheduler.reload(c); err != nil {
+		klog.Errorf("the configuration wasn't reloaded err:%v", err)
+		return
+	}
+	klog.Info("the configuration was reloaded")
+}
+
+func (s *Scheduler) reload(c *conf.Config) error {
+	// all the groups would be validated before any of them was applied
+	declared := make(map[types.Namespace]map[types.GroupName]*Group, 0)
+	for _, v := range c.Projects {
+		namespace := types.Namespace(v.Namespace)
+		declared[namespace] = make(map[types.GroupName]*Group, 0)
+		for _, v2 := range v.Groups {
+			groupName := types.GroupName(v2.Name)
+			p, err := newPipeline(namespace, groupName, v2.Pipeline)
+			if err != nil {
+				klog.V(2).Info(err)
+				return err
+			}
+			hooks, err := newHooks(namespace, groupName, v2.Hooks)
+			if err != nil {
+				klog.V(2).Info(err)
+				return err
+			}
+			if !validDuplicatePolicy(v2.Duplicate) {
+				return newError(types.CodeInvalid, ErrDuplicatePolicyWasInvalid, v2.Duplicate)
+			}
+			g := newGroup(v2.Mode, v2.Duplicate, true, p, hooks)
+			if g.approvals, err = newApprovals(v2.Approvals); err != nil {
+				klog.V(2).Info(err)
+				return err
+			}
+			declared[namespace][groupName] = g
+		}
+	}
+	// the groups which were created at runtime would be kept after they were removed from the configuration
+	created := make(map[types.Namespace]map[types.GroupName]bool, 0)
+	rows, err := s.dao.ListProjects()
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
+	for _, v := range rows {
+		if _, ok := created[types.Namespace(v.Namespace)]; !ok {
+			created[types.Namespace(v.Namespace)] = make(map[types.GroupName]bool, 0)
+		}
+		created[types.Namespace(v.Namespace)][types.GroupName(v.GroupName)] = true
+	}
+	s.projectMu.Lock()
+	s.applyConfig(declared, created, c.Permissions, c.Approvers)
+	s.projectMu.Unlock()
+	s.retireGroups()
+	s.adoptRunners()
+	return nil
+}
+
+// applyConfig adds the declared groups, updates the settings of the existing ones and retires the removed ones.
+// The created were the namespaces and the groups which were persisted by the APIs, the empty GroupName was the namespace itself.
+func (s *Scheduler) applyConfig(declared map[types.Namespace]map[types.GroupName]*Group, created map[types.Namespace]map[types.GroupName]bool,
+	permissions []conf.Permission, approvers []conf.Approver) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for namespace, groups := range declared {
+		t, ok := s.items[namespace]
+		if !ok {
+			t = newGroups(true)
+			s.items[namespace] = t
+			klog.Infof("add namespace:%s", namespace)
+		}
+		t.declared, t.retired = true, false
+		for groupName, g := range groups {
+			old, ok := t.items[groupName]
+			if !ok {
+				t.items[groupName] = g
+				klog.Infof("add namespace:%s groupName:%s", namespace, groupName)
+				continue
+			}
+			old.apply(g)
+		}
+	}
+	for namespace, t := range s.items {
+		if _, ok := declared[namespace]; !ok && t.declared {
+			t.declared = false
+			if !created[namespace][""] {
+				t.retired = true
+			}
+		}
+		for groupName, g := range t.items {
+			if _, ok := declared[namespace][groupName]; ok || !g.declared {
+				continue
+			}
+			g.declared = false
+			if !created[namespace][groupName] {
+				g.retired = true
+				klog.Infof("retire namespace:%s groupName:%s", namespace, groupName)
+			}
+		}
+	}
+	s.permissions = permissions
+	s.approvers = approvers
+}
+
+// apply updates the settings of the Group by the declared one, the Runners, the queues and the awaiting Steps would be kept.
+// The pipeline which was running would be kept until the next reloading.
+func (g *Group) apply(declared *Group) {
+	g.Mode = declared.Mode
+	g.duplicate = declared.duplicate
+	g.hooks = declared.hooks
+	g.approvals = declared.approvals
+	g.declared, g.retired = true, false
+	if g.pipeline != nil && g.pipeline.Phase == types.StepRunning {
+		klog.Warningf("the running pipeline of namespace:%s groupName:%s wasn't reloaded", g.pipeline.Namespace, g.pipeline.GroupName)
+		return
+	}
+	g.pipeline = declared.pipeline
+}
+
+// idle reports whether the Group had neither the Runners nor the requests which were queued or running
+func (g *Group) idle() bool {
+	return len(g.Runners) == 0 && len(g.queue) == 0 && (g.pipeline == nil || g.pipeline.Phase != types.StepRunning)
+}
+
+// retireGroups removes the retired groups which were idle, and the retired namespaces without any groups
+func (s *Scheduler) retireGroups() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for namespace, t := range s.items {
+		for groupName, g := range t.items {
+			if g.retired && g.idle() {
+				delete(t.items, groupName)
+				klog.Infof("remove the retired namespace:%s groupName:%s", namespace, groupName)
+			}
+		}
+		if t.retired && len(t.items) == 0 {
+			delete(s.items, namespace)
+			klog.Infof("remove the retired namespace:%s", namespace)
+		}
+	}
+}
+
+func (s *Scheduler) retireLoop() {
+	tick := time.NewTicker(RetireCheckInterval)
+	defer tick.Stop()
+	for range tick.C {
+		s.retireGroups()
+	}
+}
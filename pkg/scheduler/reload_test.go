@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestScheduler_applyConfig(t *testing.T) {
+	busy := newGroup(GroupModeDefault, DuplicatePolicyReject, true, nil, nil)
+	busy.Runners["r1"] = &types.RunnerInfo{Name: "r1"}
+	s := &Scheduler{
+		items: map[types.Namespace]*Groups{
+			"ns1": {
+				items: map[types.GroupName]*Group{
+					"g1":      newGroup(GroupModeDefault, DuplicatePolicyReject, true, nil, nil),
+					"busy":    busy,
+					"idle":    newGroup(GroupModeDefault, DuplicatePolicyReject, true, nil, nil),
+					"created": newGroup(GroupModeDefault, DuplicatePolicyReject, true, nil, nil),
+				},
+				declared: true,
+			},
+			"ns2": {
+				items: map[types.GroupName]*Group{
+					"g1": newGroup(GroupModeDefault, DuplicatePolicyReject, true, nil, nil),
+				},
+				declared: true,
+			},
+		},
+	}
+	declared := map[types.Namespace]map[types.GroupName]*Group{
+		"ns1": {
+			"g1": newGroup(GroupModePool, DuplicatePolicyReject, true, nil, nil),
+			"g2": newGroup(GroupModeDefault, DuplicatePolicyReject, true, nil, nil),
+		},
+	}
+	created := map[types.Namespace]map[types.GroupName]bool{
+		"ns1": {"created": true},
+	}
+	s.applyConfig(declared, created, nil, nil)
+	s.retireGroups()
+	tests := []struct {
+		name      string
+		namespace types.Namespace
+		want      []string
+	}{
+		{
+			name:      "TestScheduler_applyConfig_1",
+			namespace: "ns1",
+			want:      []string{"busy", "created", "g1", "g2"},
+		},
+		{
+			name:      "TestScheduler_applyConfig_2",
+			namespace: "ns2",
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			if g, ok := s.items[tt.namespace]; ok {
+				for k := range g.items {
+					got = append(got, string(k))
+				}
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applyConfig() groups = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if g := s.items["ns1"].items["g1"]; g.Mode != GroupModePool {
+		t.Errorf("applyConfig() mode = %v, want %v", g.Mode, GroupModePool)
+	}
+	if g := s.items["ns1"].items["busy"]; !g.retired {
+		t.Errorf("applyConfig() the busy group wasn't retired")
+	}
+	if g := s.items["ns1"].items["created"]; g.retired || g.declared {
+		t.Errorf("applyConfig() the created group = %+v, want a runtime one", g)
+	}
+}
@@ -0,0 +1,451 @@
+package scheduler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// HeaderRequestId was the http header whose value would be the Id of the broadcasts resulting from the request
+	HeaderRequestId = "X-Request-Id"
+	// HeaderToken was the http header of the dashboard token which manages the namespaces and the groups,
+	// or approves the gated Steps
+	HeaderToken = "X-Publisher-Token"
+)
+
+const (
+	ErrAPIParamWasInvalid = "error: param:%s value:%s was invalid"
+)
+
+// apiMessage was the protobuf message which was carried by the Request.Data of the websocket
+type apiMessage interface {
+	Marshal() ([]byte, error)
+	Unmarshal(data []byte) error
+}
+
+// apiRunStepRequest was the JSON body of running a Step by the REST API.
+// The Step would be copied from the Runner, and the Envs would overwrite its Envs.
+type apiRunStepRequest struct {
+	RunnerName string            `json:"runnerName"`
+	Selector   string            `json:"selector"`
+	Envs       map[string]string `json:"envs"`
+}
+
+// apiRunPipelineRequest was the JSON body of running the pipeline by the REST API
+type apiRunPipelineRequest struct {
+	Envs map[string]string `json:"envs"`
+}
+
+// apiPauseScheduleRequest was the JSON body of pausing or resuming a Schedule by the REST API
+type apiPauseScheduleRequest struct {
+	Paused bool `json:"paused"`
+}
+
+// apiMoveQueuedStepRequest was the JSON body of re-ordering a queued Step by the REST API
+type apiMoveQueuedStepRequest struct {
+	Position int32 `json:"position"`
+}
+
+// apiRenameRequest was the JSON body of renaming a namespace or a group by the REST API
+type apiRenameRequest struct {
+	NewName string `json:"newName"`
+}
+
+// registerAPI registers the REST API which mirrors the ServiceAPIs of the websocket
+func (s *Server) registerAPI(r *gin.RouterGroup) {
+	r.GET("/namespaces", s.apiListNamespaces)
+	r.POST("/namespaces", s.apiCreateNamespace)
+	r.PUT("/namespaces/:namespace", s.apiRenameNamespace)
+	r.DELETE("/namespaces/:namespace", s.apiDeleteNamespace)
+	r.GET("/namespaces/:namespace/groups", s.apiListGroups)
+	r.POST("/namespaces/:namespace/groups", s.apiCreateGroup)
+	r.PUT("/namespaces/:namespace/groups/:group", s.apiRenameGroup)
+	r.DELETE("/namespaces/:namespace/groups/:group", s.apiDeleteGroup)
+	r.GET("/runners/pending", s.apiListPendingRunners)
+	r.GET("/locks", s.apiListLocks)
+	g := r.Group("/namespaces/:namespace/groups/:group")
+	g.GET("/runners", s.apiListRunners)
+	g.POST("/steps/:step/run", s.apiRunStep)
+	g.POST("/runners/:runner/steps/:step/cancel", s.apiCancelStep)
+	g.POST("/runners/:runner/steps/:step/approve", s.apiApproveStep)
+	g.GET("/runners/:runner/queue", s.apiListRunnerQueue)
+	g.PUT("/runners/:runner/queue/:id", s.apiMoveQueuedStep)
+	g.DELETE("/runners/:runner/queue/:id", s.apiCancelQueuedStep)
+	g.GET("/records", s.apiListRecords)
+	g.GET("/pipeline", s.apiGetPipeline)
+	g.POST("/pipeline/run", s.apiRunPipeline)
+	g.GET("/schedules", s.apiListSchedules)
+	g.POST("/schedules", s.apiCreateSchedule)
+	g.PUT("/schedules/:id/pause", s.apiPauseSchedule)
+	g.DELETE("/schedules/:id", s.apiDeleteSchedule)
+}
+
+// apiStatus maps the error of the Scheduler to the http status, the Codes of the Response were the same as them
+func apiStatus(err error) int {
+	return int(errorCode(err))
+}
+
+func apiError(c *gin.Context, status int, err error) {
+	klog.V(2).Info(err)
+	c.JSON(status, gin.H{"error": err.Error()})
+}
+
+// serveAPI calls the handler of the websocket ServiceAPI with the marshaled req,
+// and writes the unmarshalled res as the JSON
+func serveAPI(c *gin.Context, req, res apiMessage, handler func(data []byte) ([]byte, error)) {
+	data, err := req.Marshal()
+	if err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	if data, err = handler(data); err != nil {
+		apiError(c, apiStatus(err), err)
+		return
+	}
+	if err = res.Unmarshal(data); err != nil {
+		apiError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// apiOrigin returns the origin of the broadcasts resulting from the http request by the header `X-Request-Id`
+func apiOrigin(c *gin.Context) origin {
+	return origin{
+		id:        c.GetHeader(HeaderRequestId),
+		timestamp: time.Now().UnixNano() / int64(time.Millisecond),
+	}
+}
+
+func apiNamespace(c *gin.Context) types.Namespace {
+	return types.Namespace(c.Param("namespace"))
+}
+
+func apiGroupName(c *gin.Context) types.GroupName {
+	return types.GroupName(c.Param("group"))
+}
+
+// apiInt returns the int64 value of the param or the query, the def would be returned if it was empty
+func apiInt(name, value string, def int64) (int64, error) {
+	if value == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, newError(types.CodeInvalid, ErrAPIParamWasInvalid, name, value)
+	}
+	return n, nil
+}
+
+func (s *Server) apiListNamespaces(c *gin.Context) {
+	serveAPI(c, &types.ListNamespaceRequest{}, &types.ListNamespaceResponse{}, s.connections.scheduler.handleListNamespaces)
+}
+
+func (s *Server) apiCreateNamespace(c *gin.Context) {
+	req := &types.CreateNamespaceRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	serveAPI(c, req, &types.CreateNamespaceResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleCreateNamespace(data, c.GetHeader(HeaderToken), apiOrigin(c))
+	})
+}
+
+func (s *Server) apiRenameNamespace(c *gin.Context) {
+	body := &apiRenameRequest{}
+	if err := c.ShouldBindJSON(body); err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	req := &types.RenameNamespaceRequest{
+		Namespace: apiNamespace(c),
+		NewName:   types.Namespace(body.NewName),
+	}
+	serveAPI(c, req, &types.RenameNamespaceResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleRenameNamespace(data, c.GetHeader(HeaderToken), apiOrigin(c))
+	})
+}
+
+func (s *Server) apiDeleteNamespace(c *gin.Context) {
+	req := &types.DeleteNamespaceRequest{
+		Namespace: apiNamespace(c),
+	}
+	serveAPI(c, req, &types.DeleteNamespaceResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleDeleteNamespace(data, c.GetHeader(HeaderToken), apiOrigin(c))
+	})
+}
+
+func (s *Server) apiListGroups(c *gin.Context) {
+	req := &types.ListGroupNameRequest{
+		Namespace: apiNamespace(c),
+	}
+	serveAPI(c, req, &types.ListGroupNameResponse{}, s.connections.scheduler.handleListGroupNames)
+}
+
+func (s *Server) apiCreateGroup(c *gin.Context) {
+	req := &types.CreateGroupRequest{}
+	if err := c.ShouldBindJSON(req); err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	req.Namespace = apiNamespace(c)
+	serveAPI(c, req, &types.CreateGroupResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleCreateGroup(data, c.GetHeader(HeaderToken), apiOrigin(c))
+	})
+}
+
+func (s *Server) apiRenameGroup(c *gin.Context) {
+	body := &apiRenameRequest{}
+	if err := c.ShouldBindJSON(body); err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	req := &types.RenameGroupRequest{
+		Namespace: apiNamespace(c),
+		GroupName: apiGroupName(c),
+		NewName:   types.GroupName(body.NewName),
+	}
+	serveAPI(c, req, &types.RenameGroupResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleRenameGroup(data, c.GetHeader(HeaderToken), apiOrigin(c))
+	})
+}
+
+func (s *Server) apiDeleteGroup(c *gin.Context) {
+	req := &types.DeleteGroupRequest{
+		Namespace: apiNamespace(c),
+		GroupName: apiGroupName(c),
+	}
+	serveAPI(c, req, &types.DeleteGroupResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleDeleteGroup(data, c.GetHeader(HeaderToken), apiOrigin(c))
+	})
+}
+
+// apiListPendingRunners lists the Runners which registered into the unknown groups
+func (s *Server) apiListPendingRunners(c *gin.Context) {
+	serveAPI(c, &types.ListPendingRunnersRequest{}, &types.ListPendingRunnersResponse{}, s.connections.scheduler.handleListPendingRunners)
+}
+
+// apiListLocks lists the held locks of all the groups with their holders and waiters
+func (s *Server) apiListLocks(c *gin.Context) {
+	serveAPI(c, &types.ListLocksRequest{}, &types.ListLocksResponse{}, s.connections.scheduler.handleListLocks)
+}
+
+func (s *Server) apiListRunners(c *gin.Context) {
+	req := &types.ListRunnerRequest{
+		Namespace: apiNamespace(c),
+		GroupName: apiGroupName(c),
+	}
+	serveAPI(c, req, &types.ListRunnerResponse{}, s.connections.scheduler.handleListRunners)
+}
+
+// apiRunStep runs the Step asynchronously, the result would be reported by the records and the dashboards.
+// The response contains the QueuedStep if the specified Runner was busy
+func (s *Server) apiRunStep(c *gin.Context) {
+	req := &apiRunStepRequest{}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(req); err != nil {
+			apiError(c, http.StatusBadRequest, err)
+			return
+		}
+	}
+	data, err := s.connections.scheduler.runTarget(apiNamespace(c), apiGroupName(c), req.RunnerName, c.Param("step"),
+		req.Selector, req.Envs)
+	if err != nil {
+		apiError(c, apiStatus(err), err)
+		return
+	}
+	res := &types.RunStepResponse{}
+	if err = res.Unmarshal(data); err != nil {
+		apiError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, res)
+}
+
+func (s *Server) apiCancelStep(c *gin.Context) {
+	req := &types.CancelStepRequest{
+		Namespace:  apiNamespace(c),
+		GroupName:  apiGroupName(c),
+		RunnerName: c.Param("runner"),
+		StepName:   c.Param("step"),
+	}
+	serveAPI(c, req, &types.CancelStepResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleCancelStep(data, apiOrigin(c))
+	})
+}
+
+func (s *Server) apiListRunnerQueue(c *gin.Context) {
+	req := &types.ListRunnerQueueRequest{
+		Namespace:  apiNamespace(c),
+		GroupName:  apiGroupName(c),
+		RunnerName: c.Param("runner"),
+	}
+	serveAPI(c, req, &types.ListRunnerQueueResponse{}, s.connections.scheduler.handleListRunnerQueue)
+}
+
+func (s *Server) apiMoveQueuedStep(c *gin.Context) {
+	id, err := apiInt("id", c.Param("id"), 0)
+	if err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	body := &apiMoveQueuedStepRequest{}
+	if err = c.ShouldBindJSON(body); err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	req := &types.MoveQueuedStepRequest{
+		Namespace:  apiNamespace(c),
+		GroupName:  apiGroupName(c),
+		RunnerName: c.Param("runner"),
+		Id:         id,
+		Position:   body.Position,
+	}
+	serveAPI(c, req, &types.MoveQueuedStepResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleMoveQueuedStep(data, apiOrigin(c))
+	})
+}
+
+func (s *Server) apiCancelQueuedStep(c *gin.Context) {
+	id, err := apiInt("id", c.Param("id"), 0)
+	if err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	req := &types.CancelQueuedStepRequest{
+		Namespace:  apiNamespace(c),
+		GroupName:  apiGroupName(c),
+		RunnerName: c.Param("runner"),
+		Id:         id,
+	}
+	serveAPI(c, req, &types.CancelQueuedStepResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleCancelQueuedStep(data, apiOrigin(c))
+	})
+}
+
+// apiApproveStep approves the gated Step as the approver of the header `X-Publisher-Token`
+func (s *Server) apiApproveStep(c *gin.Context) {
+	req := &types.ApproveStepRequest{
+		Namespace:  apiNamespace(c),
+		GroupName:  apiGroupName(c),
+		RunnerName: c.Param("runner"),
+		StepName:   c.Param("step"),
+	}
+	serveAPI(c, req, &types.ApproveStepResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleApproveStep(data, c.GetHeader(HeaderToken), apiOrigin(c))
+	})
+}
+
+// apiListRecords lists the records by the query `page`, `length` and `version`,
+// the out of range ones would be answered with the http.StatusBadRequest
+func (s *Server) apiListRecords(c *gin.Context) {
+	req := &types.ListRecordsRequest{
+		Namespace:  apiNamespace(c),
+		GroupName:  apiGroupName(c),
+		RunnerName: c.Query("runner"),
+	}
+	for _, v := range []struct {
+		name  string
+		value *int32
+		def   int64
+	}{
+		{"page", &req.Page, 0},
+		{"length", &req.Length, 20},
+		{"version", &req.IsVersion, types.RecordDefault},
+	} {
+		n, err := apiInt(v.name, c.Query(v.name), v.def)
+		if err == nil && n != int64(int32(n)) {
+			err = newError(types.CodeInvalid, ErrAPIParamWasInvalid, v.name, c.Query(v.name))
+		}
+		if err != nil {
+			apiError(c, http.StatusBadRequest, err)
+			return
+		}
+		*v.value = int32(n)
+	}
+	serveAPI(c, req, &types.ListRecordsResponse{}, s.connections.scheduler.handleListRecordsRequest)
+}
+
+func (s *Server) apiGetPipeline(c *gin.Context) {
+	req := &types.GetPipelineRequest{
+		Namespace: apiNamespace(c),
+		GroupName: apiGroupName(c),
+	}
+	serveAPI(c, req, &types.GetPipelineResponse{}, s.connections.scheduler.handleGetPipeline)
+}
+
+func (s *Server) apiRunPipeline(c *gin.Context) {
+	body := &apiRunPipelineRequest{}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(body); err != nil {
+			apiError(c, http.StatusBadRequest, err)
+			return
+		}
+	}
+	req := &types.RunPipelineRequest{
+		Namespace: apiNamespace(c),
+		GroupName: apiGroupName(c),
+		Envs:      body.Envs,
+	}
+	serveAPI(c, req, &types.RunPipelineResponse{}, func(data []byte) ([]byte, error) {
+		return s.connections.scheduler.handleRunPipeline(data, apiOrigin(c))
+	})
+}
+
+func (s *Server) apiListSchedules(c *gin.Context) {
+	req := &types.ListSchedulesRequest{
+		Namespace: apiNamespace(c),
+		GroupName: apiGroupName(c),
+	}
+	serveAPI(c, req, &types.ListSchedulesResponse{}, s.connections.scheduler.handleListSchedules)
+}
+
+func (s *Server) apiCreateSchedule(c *gin.Context) {
+	req := &types.CreateScheduleRequest{}
+	if err := c.ShouldBindJSON(&req.Schedule); err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	req.Schedule.Namespace, req.Schedule.GroupName = apiNamespace(c), apiGroupName(c)
+	serveAPI(c, req, &types.CreateScheduleResponse{}, s.connections.scheduler.handleCreateSchedule)
+}
+
+func (s *Server) apiPauseSchedule(c *gin.Context) {
+	id, err := apiInt("id", c.Param("id"), 0)
+	if err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	body := &apiPauseScheduleRequest{}
+	if err = c.ShouldBindJSON(body); err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	req := &types.PauseScheduleRequest{
+		Namespace: apiNamespace(c),
+		GroupName: apiGroupName(c),
+		Id:        id,
+		Paused:    body.Paused,
+	}
+	serveAPI(c, req, &types.PauseScheduleResponse{}, s.connections.scheduler.handlePauseSchedule)
+}
+
+func (s *Server) apiDeleteSchedule(c *gin.Context) {
+	id, err := apiInt("id", c.Param("id"), 0)
+	if err != nil {
+		apiError(c, http.StatusBadRequest, err)
+		return
+	}
+	req := &types.DeleteScheduleRequest{
+		Namespace: apiNamespace(c),
+		GroupName: apiGroupName(c),
+		Id:        id,
+	}
+	serveAPI(c, req, &types.DeleteScheduleResponse{}, s.connections.scheduler.handleDeleteSchedule)
+}
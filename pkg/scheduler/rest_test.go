@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func Test_apiStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{
+			name: "Test_apiStatus_1",
+			err:  newError(types.CodeNotFound, ErrGroupWasNotExisted, "ns", "g"),
+			want: http.StatusNotFound,
+		},
+		{
+			name: "Test_apiStatus_2",
+			err:  fmt.Errorf("run: %w", newError(types.CodeBusy, ErrPipelineWasRunning, "ns", "g")),
+			want: http.StatusConflict,
+		},
+		{
+			name: "Test_apiStatus_3",
+			err: func() error {
+				_, err := parseCron("* *")
+				return err
+			}(),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "Test_apiStatus_4",
+			err:  fmt.Errorf("error: unexpected"),
+			want: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := apiStatus(tt.err); got != tt.want {
+				t.Errorf("apiStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
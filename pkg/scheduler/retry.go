@@ -0,0 +1,105 @@
+package scheduler
+
+import (
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// scheduleRetry reports whether the failed Step would be run again after the backoff of its RetryPolicy.
+// The Runner would be kept busy until the retry, so that the queued requests wouldn't be run before it.
+func (s *Scheduler) scheduleRetry(g *Group, namespace types.Namespace, groupName types.GroupName, runnerName string, step *types.Step) bool {
+	if step.Phase != types.StepFailed || !step.Retry.Retryable(step.Attempt, step.FailedReason()) {
+		return false
+	}
+	stepName, attempt := step.Name, step.Attempt
+	d := step.Retry.Backoff(attempt)
+	klog.Infof("retry step:%s runner:%s namespace:%s groupName:%s attempt:%d after:%v", stepName, runnerName, namespace, groupName, attempt+1, d)
+	s.retries.watch(stepKey(namespace, groupName, runnerName, stepName), d, func() {
+		s.retryStep(g, namespace, groupName, runnerName, stepName, attempt)
+	})
+	return true
+}
+
+// retryStep sends the Step which failed at the attempt to the Runner again with the next attempt
+func (s *Scheduler) retryStep(g *Group, namespace types.Namespace, groupName types.GroupName, runnerName, stepName string, attempt int32) {
+	s.mu.Lock()
+	ri, ok := g.Runners[runnerName]
+	if !ok {
+		s.mu.Unlock()
+		klog.V(2).Infof(ErrRunnerWasNotExisted, namespace, groupName, runnerName)
+		s.terminateStep(g, namespace, groupName, runnerName, stepName, types.StepFailed)
+		return
+	}
+	var step *types.Step
+	for i, v := range ri.Steps {
+		if v.Name != stepName || v.Phase != types.StepFailed || v.Attempt != attempt {
+			continue
+		}
+		step = v.DeepCopy()
+		step.Phase = types.StepRunning
+		step.Attempt = attempt + 1
+		step.Reason = ""
+		step.RunnerName = runnerName
+		ri.Steps[i] = *step
+	}
+	if step == nil {
+		s.mu.Unlock()
+		// the Step had been changed by the others during the backoff
+		s.terminateStep(g, namespace, groupName, runnerName, stepName, types.StepFailed)
+		return
+	}
+	g.markRunning(runnerName, stepName, step.Attempt)
+	s.persistRunner(ri)
+	s.mu.Unlock()
+	klog.Infof("retryStep name:%s attempt:%d", stepName, step.Attempt)
+	if err := s.updateStepToDashboard(namespace, groupName, runnerName, step.DeepCopy(), origin{}); err != nil {
+		klog.V(2).Info(err)
+	}
+	if err := s.runStepToRunner(namespace, groupName, runnerName, step, origin{}); err != nil {
+		klog.V(2).Info(err)
+		s.failStep(g, ri, stepName, types.StepReasonError, err.Error())
+	}
+}
+
+// cancelRetry reports whether the Step was waiting for the retry, the waiting one would be terminated as StepFailed
+func (s *Scheduler) cancelRetry(g *Group, ri *types.RunnerInfo, stepName string) bool {
+	if !s.retries.stop(stepKey(ri.Namespace, ri.GroupName, ri.Name, stepName)) {
+		return false
+	}
+	klog.Infof("cancel the retry of step:%s runner:%s namespace:%s groupName:%s", stepName, ri.Name, ri.Namespace, ri.GroupName)
+	s.failStep(g, ri, stepName, types.StepReasonCancelled, types.StepTerminatedMessage(stepName, types.StepReasonCancelled))
+	return true
+}
+
+// failStep marks the Step as StepFailed by the reason with the message, and terminates it
+func (s *Scheduler) failStep(g *Group, ri *types.RunnerInfo, stepName, reason, message string) {
+	s.mu.Lock()
+	failed := make([]*types.Step, 0, 1)
+	for i, v := range ri.Steps {
+		if v.Name != stepName {
+			continue
+		}
+		v.Phase = types.StepFailed
+		v.Reason = reason
+		v.Messages = append(v.Messages, message)
+		ri.Steps[i] = v
+		failed = append(failed, v.DeepCopy())
+	}
+	s.persistRunner(ri)
+	s.mu.Unlock()
+	for _, v := range failed {
+		go s.recordStep(ri, v.DeepCopy())
+		if err := s.updateStepToDashboard(ri.Namespace, ri.GroupName, ri.Name, v, origin{}); err != nil {
+			klog.V(2).Info(err)
+		}
+	}
+	s.terminateStep(g, ri.Namespace, ri.GroupName, ri.Name, stepName, types.StepFailed)
+}
+
+// terminateStep releases the Runner and the locks, and completes the Schedules and the pipeline by the terminated phase of the Step
+func (s *Scheduler) terminateStep(g *Group, namespace types.Namespace, groupName types.GroupName, runnerName, stepName string, phase types.StepPhase) {
+	go s.releaseRunner(namespace, groupName, runnerName, stepName, 0)
+	go s.releaseLocks(namespace, groupName, runnerName, stepName)
+	go s.completeSchedules(namespace, groupName, runnerName, stepName, phase)
+	go s.advancePipeline(g, runnerName, stepName, phase)
+}
@@ -0,0 +1,143 @@
+package scheduler
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestScheduler_scheduleRetry(t *testing.T) {
+	retry := types.RetryPolicy{
+		MaxAttempts:     3,
+		BackoffInSec:    60,
+		MaxBackoffInSec: 90,
+		RetryOn:         []string{types.StepReasonError},
+	}
+	tests := []struct {
+		name        string
+		phase       types.StepPhase
+		attempt     int32
+		reason      string
+		output      string
+		want        bool
+		wantBackoff time.Duration
+	}{
+		{
+			name:        "TestScheduler_scheduleRetry_1",
+			phase:       types.StepFailed,
+			attempt:     1,
+			want:        true,
+			wantBackoff: time.Second * 60,
+		},
+		{
+			name:        "TestScheduler_scheduleRetry_2",
+			phase:       types.StepFailed,
+			attempt:     2,
+			want:        true,
+			wantBackoff: time.Second * 90,
+		},
+		{
+			name:    "TestScheduler_scheduleRetry_3",
+			phase:   types.StepFailed,
+			attempt: 3,
+			want:    false,
+		},
+		{
+			name:    "TestScheduler_scheduleRetry_4",
+			phase:   types.StepFailed,
+			attempt: 1,
+			reason:  types.StepReasonTimeout,
+			want:    false,
+		},
+		{
+			name:    "TestScheduler_scheduleRetry_5",
+			phase:   types.StepSucceeded,
+			attempt: 1,
+			want:    false,
+		},
+		{
+			// the reason was carried by the Step, the output which looked like the terminated message didn't matter
+			name:        "TestScheduler_scheduleRetry_6",
+			phase:       types.StepFailed,
+			attempt:     1,
+			output:      types.StepTerminatedMessage("upload", types.StepReasonTimeout),
+			want:        true,
+			wantBackoff: time.Second * 60,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				retries: newWatchdog(),
+			}
+			step := &types.Step{
+				Name:    "upload",
+				Phase:   tt.phase,
+				Retry:   retry,
+				Attempt: tt.attempt,
+			}
+			if tt.reason != "" {
+				step.Reason = tt.reason
+				step.Messages = []string{types.StepTerminatedMessage(step.Name, tt.reason)}
+			}
+			if tt.output != "" {
+				step.Messages = []string{tt.output}
+			}
+			if got := s.scheduleRetry(newGroup("", "", true, nil, nil), "ns", "g", "r1", step); got != tt.want {
+				t.Errorf("scheduleRetry() = %v, want %v", got, tt.want)
+			}
+			if got := s.retries.stop(stepKey("ns", "g", "r1", step.Name)); got != tt.want {
+				t.Errorf("retries.stop() = %v, want %v", got, tt.want)
+			}
+			if tt.want {
+				if got := step.Retry.Backoff(tt.attempt); got != tt.wantBackoff {
+					t.Errorf("Backoff() = %v, want %v", got, tt.wantBackoff)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		retry   types.RetryPolicy
+		attempt int32
+		want    time.Duration
+	}{
+		{
+			name:    "TestRetryPolicy_Backoff_1",
+			retry:   types.RetryPolicy{BackoffInSec: 60, MaxBackoffInSec: 90},
+			attempt: 5,
+			want:    time.Second * 90,
+		},
+		{
+			name:    "TestRetryPolicy_Backoff_2",
+			retry:   types.RetryPolicy{BackoffInSec: 60},
+			attempt: 3,
+			want:    time.Second * 240,
+		},
+		{
+			// the doubling without the MaxBackoffInSec stops before the overflow
+			name:    "TestRetryPolicy_Backoff_3",
+			retry:   types.RetryPolicy{BackoffInSec: 60},
+			attempt: 100,
+			want:    time.Second * 60 << 27,
+		},
+		{
+			name:    "TestRetryPolicy_Backoff_4",
+			retry:   types.RetryPolicy{BackoffInSec: 60},
+			attempt: math.MaxInt32,
+			want:    time.Second * 60 << 27,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.retry.Backoff(tt.attempt); got != tt.want {
+				t.Errorf("Backoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package scheduler
 
 import (
 	"context"
-	"fmt"
 	"github.com/Shanghai-Lunara/publisher/pkg/types"
 	"k8s.io/klog/v2"
 	"sync/atomic"
@@ -35,6 +34,17 @@ type Runner struct {
 	cancel                    context.CancelFunc
 	RecordFunc                func(ri *types.RunnerInfo, step *types.Step)
 	UpdateStepToDashboardFunc func(namespace types.Namespace, groupName types.GroupName, runnerName string, step *types.Step) (err error)
+
+	// load was the count of the Steps which had been sent to the Runner
+	load int32
+	// step and attempt were the Step which kept the Runner busy, the attempt was 0 before the Step was sent to the Runner
+	step    string
+	attempt int32
+}
+
+// ownedBy reports whether the Runner was kept busy by the Step, the attempt 0 matches all the attempts of the Step
+func (r *Runner) ownedBy(stepName string, attempt int32) bool {
+	return r.status == Running && r.step == stepName && (attempt == 0 || r.attempt == attempt)
 }
 
 func (r *Runner) UpdateStep(req *types.Step, body types.Body) (res []byte, tn *triggerNext, err error) {
@@ -99,7 +109,7 @@ func (r *Runner) UpdateStep(req *types.Step, body types.Body) (res []byte, tn *t
 	}
 	r.info.Steps = newSteps
 	if !exist {
-		return nil, tn, fmt.Errorf(ErrStepWasNotExisted, r.info.Namespace, r.info.GroupName, req.RunnerName, req.Name)
+		return nil, tn, newError(types.CodeNotFound, ErrStepWasNotExisted, r.info.Namespace, r.info.GroupName, req.RunnerName, req.Name)
 	}
 	return res, tn, nil
 }
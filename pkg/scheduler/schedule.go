@@ -0,0 +1,334 @@
+package scheduler
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// ScheduleTickInterval was the interval of checking the Schedules which should be fired
+	ScheduleTickInterval = time.Second
+)
+
+const (
+	ErrScheduleWasNotExisted    = "error: namespace:%s groupName:%s schedule:%d was not existed"
+	ErrScheduleStepWasRequired  = "error: namespace:%s groupName:%s schedule:%s runner:%s step was required"
+	ErrScheduleCronWasNotFiring = "error: schedule:%s cron:%s would never be fired"
+)
+
+// schedules holds all the Schedules of the Scheduler by their ids
+type schedules struct {
+	mu    sync.Mutex
+	items map[int64]*scheduleEntry
+}
+
+type scheduleEntry struct {
+	schedule *types.Schedule
+	cron     *cronExpr
+}
+
+func newSchedules() *schedules {
+	return &schedules{
+		items: make(map[int64]*scheduleEntry, 0),
+	}
+}
+
+// resetNextFire computes the NextFireTM from the t, it would be zero if the Schedule was paused
+func (e *scheduleEntry) resetNextFire(t time.Time) {
+	e.schedule.NextFireTM = 0
+	if e.schedule.Paused {
+		return
+	}
+	if next := e.cron.Next(t); !next.IsZero() {
+		e.schedule.NextFireTM = next.Unix()
+	}
+}
+
+// loadSchedules restores the persisted Schedules, the firings which were missed during the downtime were skipped
+func (s *Scheduler) loadSchedules() {
+	rows, err := s.dao.ListSchedules()
+	if err != nil {
+		klog.V(2).Info(err)
+		return
+	}
+	now := time.Now()
+	s.schedules.mu.Lock()
+	defer s.schedules.mu.Unlock()
+	// the Schedules which were deleted by the previous leader would be dropped after the takeover
+	s.schedules.items = make(map[int64]*scheduleEntry, len(rows))
+	for _, v := range rows {
+		sc := &types.Schedule{}
+		if err = sc.Unmarshal(v.Data); err != nil {
+			klog.V(2).Info(err)
+			continue
+		}
+		sc.Id = v.Id
+		if sc.LastResult == string(types.StepRunning) {
+			// the result was lost during the downtime
+			sc.LastResult = string(types.StepUnknown)
+		}
+		c, err := parseCron(sc.Cron)
+		if err != nil {
+			klog.V(2).Info(err)
+			continue
+		}
+		e := &scheduleEntry{schedule: sc, cron: c}
+		e.resetNextFire(now)
+		s.schedules.items[sc.Id] = e
+	}
+}
+
+func (s *Scheduler) saveSchedule(sc *types.Schedule) {
+	data, err := sc.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		return
+	}
+	if err = s.dao.UpdateSchedule(sc.Id, data); err != nil {
+		klog.V(2).Info(err)
+	}
+}
+
+func (s *Scheduler) runSchedules() {
+	tick := time.NewTicker(ScheduleTickInterval)
+	defer tick.Stop()
+	for now := range tick.C {
+		if s.isLeading() {
+			s.fireSchedules(now)
+		}
+	}
+}
+
+// fireSchedules fires all the Schedules whose NextFireTM had come
+func (s *Scheduler) fireSchedules(now time.Time) {
+	due := make([]*types.Schedule, 0)
+	s.schedules.mu.Lock()
+	for _, e := range s.schedules.items {
+		if e.schedule.Paused || e.schedule.NextFireTM == 0 || e.schedule.NextFireTM > now.Unix() {
+			continue
+		}
+		e.schedule.LastFireTM = now.Unix()
+		e.schedule.LastResult = string(types.StepRunning)
+		e.resetNextFire(now)
+		due = append(due, e.schedule.DeepCopy())
+	}
+	s.schedules.mu.Unlock()
+	for _, sc := range due {
+		klog.Infof("fireSchedule id:%d name:%s namespace:%s groupName:%s", sc.Id, sc.Name, sc.Namespace, sc.GroupName)
+		if err := s.fireSchedule(sc); err != nil {
+			klog.V(2).Info(err)
+			s.schedules.mu.Lock()
+			if e, ok := s.schedules.items[sc.Id]; ok {
+				e.schedule.LastResult = err.Error()
+				sc = e.schedule.DeepCopy()
+			}
+			s.schedules.mu.Unlock()
+		}
+		s.saveSchedule(sc)
+	}
+}
+
+// fireSchedule runs the pipeline if the StepName was empty, or runs the Step with the Envs of the Schedule
+func (s *Scheduler) fireSchedule(sc *types.Schedule) error {
+	_, err := s.runTarget(sc.Namespace, sc.GroupName, sc.RunnerName, sc.StepName, "", sc.Envs)
+	return err
+}
+
+// runTarget runs the pipeline of the Group if the stepName was empty, or runs the Step on the Runner.
+// The Step would be run on an idle Runner which offers it and matches the selector if the runnerName was empty.
+// The envs would overwrite the Envs of the Steps. The res was the RunStepResponse, it was nil for the pipeline.
+func (s *Scheduler) runTarget(namespace types.Namespace, groupName types.GroupName, runnerName, stepName, selector string, envs map[string]string) (res []byte, err error) {
+	if stepName == "" {
+		req := &types.RunPipelineRequest{
+			Namespace: namespace,
+			GroupName: groupName,
+			Envs:      envs,
+		}
+		data, err := req.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		_, err = s.handleRunPipeline(data, origin{})
+		return nil, err
+	}
+	g, err := s.getGroup(namespace, groupName)
+	if err != nil {
+		return nil, err
+	}
+	// the Step was copied from the Runner, or from any Runner which offers it if the runnerName was empty
+	var step *types.Step
+	s.mu.Lock()
+	names := make([]string, 0, len(g.Runners))
+	for k := range g.Runners {
+		if runnerName == "" || runnerName == k {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		for _, v := range g.Runners[k].Steps {
+			if step == nil && v.Name == stepName {
+				step = v.DeepCopy()
+			}
+		}
+	}
+	s.mu.Unlock()
+	if step == nil {
+		return nil, newError(types.CodeNotFound, ErrStepWasNotExisted, namespace, groupName, runnerName, stepName)
+	}
+	step.Envs = mergeMap(step.Envs, envs)
+	step.RunnerName = runnerName
+	req := &types.RunStepRequest{
+		Namespace:  namespace,
+		GroupName:  groupName,
+		RunnerName: runnerName,
+		Step:       *step,
+		Selector:   selector,
+	}
+	return s.runStep(req, origin{}, true, false)
+}
+
+// completeSchedules records the terminated phase as the LastResult of the fired Schedules of the Step,
+// the stepName was empty for the pipeline
+func (s *Scheduler) completeSchedules(namespace types.Namespace, groupName types.GroupName, runnerName, stepName string, phase types.StepPhase) {
+	done := make([]*types.Schedule, 0)
+	s.schedules.mu.Lock()
+	for _, e := range s.schedules.items {
+		sc := e.schedule
+		if sc.Namespace != namespace || sc.GroupName != groupName || sc.StepName != stepName ||
+			sc.LastResult != string(types.StepRunning) {
+			continue
+		}
+		if sc.RunnerName != "" && sc.RunnerName != runnerName {
+			continue
+		}
+		sc.LastResult = string(phase)
+		done = append(done, sc.DeepCopy())
+	}
+	s.schedules.mu.Unlock()
+	for _, v := range done {
+		s.saveSchedule(v)
+	}
+}
+
+func (s *Scheduler) handleListSchedules(data []byte) (res []byte, err error) {
+	req := &types.ListSchedulesRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	result := &types.ListSchedulesResponse{
+		Items: make([]types.Schedule, 0),
+	}
+	s.schedules.mu.Lock()
+	for _, e := range s.schedules.items {
+		if e.schedule.Namespace == req.Namespace && e.schedule.GroupName == req.GroupName {
+			result.Items = append(result.Items, *e.schedule.DeepCopy())
+		}
+	}
+	s.schedules.mu.Unlock()
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Id < result.Items[j].Id
+	})
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleCreateSchedule(data []byte) (res []byte, err error) {
+	req := &types.CreateScheduleRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	sc := req.Schedule.DeepCopy()
+	var g *Group
+	if g, err = s.getGroup(sc.Namespace, sc.GroupName); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	if sc.StepName == "" {
+		if sc.RunnerName != "" {
+			return nil, newError(types.CodeInvalid, ErrScheduleStepWasRequired, sc.Namespace, sc.GroupName, sc.Name, sc.RunnerName)
+		}
+		s.mu.Lock()
+		declared := g.pipeline != nil
+		s.mu.Unlock()
+		if !declared {
+			return nil, newError(types.CodeNotFound, ErrPipelineWasNotExisted, sc.Namespace, sc.GroupName)
+		}
+	}
+	c, err := parseCron(sc.Cron)
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	e := &scheduleEntry{schedule: sc, cron: c}
+	sc.LastFireTM, sc.LastResult = 0, ""
+	e.resetNextFire(time.Now())
+	if !sc.Paused && sc.NextFireTM == 0 {
+		return nil, newError(types.CodeInvalid, ErrScheduleCronWasNotFiring, sc.Name, sc.Cron)
+	}
+	if data, err = sc.Marshal(); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	if sc.Id, err = s.dao.InsertSchedule(string(sc.Namespace), string(sc.GroupName), data); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.schedules.mu.Lock()
+	s.schedules.items[sc.Id] = e
+	result := &types.CreateScheduleResponse{
+		Schedule: *sc.DeepCopy(),
+	}
+	s.schedules.mu.Unlock()
+	return result.Marshal()
+}
+
+func (s *Scheduler) handlePauseSchedule(data []byte) (res []byte, err error) {
+	req := &types.PauseScheduleRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	s.schedules.mu.Lock()
+	e, ok := s.schedules.items[req.Id]
+	if !ok || e.schedule.Namespace != req.Namespace || e.schedule.GroupName != req.GroupName {
+		s.schedules.mu.Unlock()
+		return nil, newError(types.CodeNotFound, ErrScheduleWasNotExisted, req.Namespace, req.GroupName, req.Id)
+	}
+	e.schedule.Paused = req.Paused
+	e.resetNextFire(time.Now())
+	sc := e.schedule.DeepCopy()
+	s.schedules.mu.Unlock()
+	s.saveSchedule(sc)
+	result := &types.PauseScheduleResponse{
+		Schedule: *sc,
+	}
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleDeleteSchedule(data []byte) (res []byte, err error) {
+	req := &types.DeleteScheduleRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	s.schedules.mu.Lock()
+	e, ok := s.schedules.items[req.Id]
+	if !ok || e.schedule.Namespace != req.Namespace || e.schedule.GroupName != req.GroupName {
+		s.schedules.mu.Unlock()
+		return nil, newError(types.CodeNotFound, ErrScheduleWasNotExisted, req.Namespace, req.GroupName, req.Id)
+	}
+	delete(s.schedules.items, req.Id)
+	s.schedules.mu.Unlock()
+	if err = s.dao.DeleteSchedule(req.Id); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	result := &types.DeleteScheduleResponse{}
+	return result.Marshal()
+}
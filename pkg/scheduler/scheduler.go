@@ -9,27 +9,55 @@ import (
 	"github.com/Shanghai-Lunara/publisher/pkg/types"
 	"k8s.io/klog/v2"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 )
 
 func NewScheduler(broadcast chan *broadcast, c *conf.Config) *Scheduler {
 	s := &Scheduler{
-		items:     make(map[types.Namespace]*Groups, 0),
-		broadcast: broadcast,
-		dao:       dao.Get(),
-	}
+		items:         make(map[types.Namespace]*Groups, 0),
+		broadcast:     broadcast,
+		dao:           dao.Get(),
+		watchdog:      newWatchdog(),
+		retries:       newWatchdog(),
+		logSeqs:       make(map[string]int64, 0),
+		states:        newPendingStates(),
+		schedules:     newSchedules(),
+		pending:       make(map[int32]*types.RunnerInfo, 0),
+		permissions:   c.Permissions,
+		approvers:     c.Approvers,
+		locks:         make(map[string]*resourceLock, 0),
+		subscriptions: newSubscriptions(),
+	}
+	// the Scheduler would be the leader after it took over the lease if the HA was enabled
+	s.setLeading(c.HA.Id == "")
 	for _, v := range c.Projects {
-		s.items[types.Namespace(v.Namespace)] = &Groups{
-			items: make(map[types.GroupName]*Group, 0),
-		}
+		s.items[types.Namespace(v.Namespace)] = newGroups(true)
 		for _, v2 := range v.Groups {
-			s.items[types.Namespace(v.Namespace)].items[types.GroupName(v2.Name)] = &Group{
-				Runners: make(map[string]*types.RunnerInfo, 0),
-				Ids:     make(map[int32]string, 0),
+			p, err := newPipeline(types.Namespace(v.Namespace), types.GroupName(v2.Name), v2.Pipeline)
+			if err != nil {
+				klog.Fatal(err)
+			}
+			hooks, err := newHooks(types.Namespace(v.Namespace), types.GroupName(v2.Name), v2.Hooks)
+			if err != nil {
+				klog.Fatal(err)
+			}
+			if !validDuplicatePolicy(v2.Duplicate) {
+				klog.Fatalf(ErrDuplicatePolicyWasInvalid, v2.Duplicate)
 			}
+			g := newGroup(v2.Mode, v2.Duplicate, true, p, hooks)
+			if g.approvals, err = newApprovals(v2.Approvals); err != nil {
+				klog.Fatal(err)
+			}
+			s.items[types.Namespace(v.Namespace)].items[types.GroupName(v2.Name)] = g
 		}
 	}
+	s.loadProjects()
+	go s.persistLoop()
+	s.loadSchedules()
+	go s.runSchedules()
+	go s.retireLoop()
 	return s
 }
 
@@ -38,37 +66,119 @@ type Scheduler struct {
 	dao       *dao.Dao
 	items     map[types.Namespace]*Groups
 	broadcast chan<- *broadcast
+	watchdog  *watchdog
+	// retries hold the timers of the failed Steps which were waiting for the retry
+	retries *watchdog
+	// logSeqs were the last received Seq of the LogStreamRequest from each Runner
+	logSeqs map[string]int64
+	// states were the latest RunnerInfos which were waiting to be persisted
+	states    *pendingStates
+	schedules *schedules
+	// pending were the Runners which registered into the unknown groups by their connections
+	pending map[int32]*types.RunnerInfo
+	// permissions were the dashboard tokens which could manage the namespaces and the groups
+	permissions []conf.Permission
+	// approvers were the dashboard users who could approve the gated Steps
+	approvers []conf.Approver
+	// locks were the resource locks which were held by the running Steps of all the groups by their names
+	locks map[string]*resourceLock
+	// subscriptions were the Subscriptions of the dashboards which route the broadcasts
+	subscriptions *subscriptions
+	// leading was 1 if the Scheduler was the leader, only the leader fires the Schedules
+	leading int32
+	// projectMu serializes the management of the namespaces and the groups
+	projectMu sync.Mutex
 }
 
 type Groups struct {
 	items map[types.GroupName]*Group
+	// declared was true if the namespace was declared in the configuration, it couldn't be renamed or deleted
+	declared bool
+	// retired was true if the namespace was removed from the configuration, it would be removed without any groups
+	retired bool
 }
 
 type Group struct {
 	Runners map[string]*types.RunnerInfo `json:"runners" protobuf:"bytes,1,opt,name=runners"`
 	Ids     map[int32]string
+	// sessions were the connections of each Runner in the order of the registration,
+	// there would be more than one in the DuplicatePolicyPool
+	sessions map[string][]int32
+	// duplicate was the DuplicatePolicy of the Runners which registered with the same name
+	duplicate string
+	// Mode was GroupModeDefault or GroupModePool
+	Mode string
+	// pool tracks the busy state of each Runner
+	pool map[string]*Runner
+	// queue holds the RunStepRequests which were waiting for an idle Runner in the GroupModePool
+	queue []*types.RunStepRequest
+	// runQueues hold the RunStepRequests which specified the busy Runners, they would be run in the FIFO order
+	runQueues map[string][]*types.QueuedStep
+	// queueSeq was the last Id of the QueuedSteps
+	queueSeq int64
+	// approvals were the numbers of the distinct approvers which the gated Steps required by the step names
+	approvals map[string]int32
+	// gates were the requests of the gated Steps which were awaiting the approvals by the runner/step keys
+	gates map[string]*gate
+	// pipeline was nil if it wasn't declared in the configuration
+	pipeline *types.Pipeline
+	// hooks were the inbound webhooks by their names
+	hooks map[string]*conf.Hook
+	// declared was true if the Group was declared in the configuration, it couldn't be renamed or deleted
+	declared bool
+	// retired was true if the Group was removed from the configuration, it would be removed after it was idle
+	retired bool
 }
 
 func (s *Scheduler) removeRunner(id int32) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	for _, v := range s.items {
-		for _, v2 := range v.items {
-			if name, ok := v2.Ids[id]; ok {
-				delete(v2.Runners, name)
-				delete(v2.Ids, id)
+	delete(s.pending, id)
+	for k, v := range s.items {
+		for k2, v2 := range v.items {
+			if name, ok := v2.Ids[id]; ok && v2.deleteSession(name, id) {
+				s.dropRunner(v2, k, k2, name)
 			}
 		}
 	}
+	// the Runner which was rejected as a duplicate could be registered now
+	go s.adoptRunners()
 }
 
-func (s *Scheduler) handle(message []byte, clientId int32) (res []byte, err error) {
+// dropRunner removes the Runner which had no connections anymore, the caller must hold the s.mu
+func (s *Scheduler) dropRunner(g *Group, namespace types.Namespace, groupName types.GroupName, name string) {
+	delete(g.Runners, name)
+	g.deleteRunner(name)
+	// the queued requests and the awaiting ones would be dropped since the Runner had disconnected
+	if _, ok := g.runQueues[name]; ok {
+		delete(g.runQueues, name)
+		s.queueToDashboard(g, namespace, groupName, name, origin{})
+	}
+	for key, t := range g.gates {
+		if t.req.RunnerName == name {
+			delete(g.gates, key)
+		}
+	}
+	// the locks held by the Runner would be released, and the others would be woken up
+	s.dropLockWaiters(namespace, groupName, name, "")
+	go s.wakeLockWaiters(s.unlock(namespace, groupName, name, ""))
+}
+
+// handle answers the Request with a Response, the error would be answered by the Code and the Message of it.
+// The body was the kind of the connection which sent the Request, and the token was the token of the dashboard.
+func (s *Scheduler) handle(message []byte, clientId int32, body types.Body, token string) (res []byte, err error) {
 	req := &types.Request{}
 	if err = req.Unmarshal(message); err != nil {
 		klog.V(2).Info(err)
-		return res, err
+		return errorResponse(req.Type, origin{}, newError(types.CodeInvalid, ErrRequestWasInvalid, err)).Marshal()
 	}
 	reqType := req.Type
+	o := origin{id: req.Id, timestamp: req.Timestamp}
+	if !allowedServiceAPI(req.Type.ServiceAPI, body) {
+		err = newError(types.CodeForbidden, ErrServiceAPIWasForbidden, req.Type.ServiceAPI, body)
+		klog.V(2).Info(err)
+		return errorResponse(reqType, o, err).Marshal()
+	}
 	switch req.Type.ServiceAPI {
 	case types.Ping:
 		res, err = s.handlePing(req.Data, clientId)
@@ -84,21 +194,68 @@ func (s *Scheduler) handle(message []byte, clientId int32) (res []byte, err erro
 		// RunStep must be sent from the Dashboard in the Scheduler handler.
 		// And then the command would be transmitted to the specific Runner.
 		// At the same time, the Runner status would be changed and synced to all dashboards.
-		res, err = s.handleRunStep(req.Data)
+		res, err = s.handleRunStep(req.Data, o)
 	case types.UpdateStep:
 		var tn *triggerNext
-		res, tn, err = s.handleUpdateStep(req.Data, req.Type.Body)
-		if req.Type.Body == types.BodyRunner && tn != nil && tn.next == true {
+		// the kind of the connection decides whether it was reported by the Runner, the Body of the Type was sent by the client
+		res, tn, err = s.handleUpdateStep(req.Data, body, o)
+		if body == types.BodyRunner && tn != nil && tn.next == true {
 			go func() {
-				_, err := s.triggerRunStep(tn.ri, tn.step)
+				_, err := s.triggerRunStep(tn.ri, tn.step, origin{}, false)
 				if err != nil {
 					klog.V(2).Info(err)
+					s.releaseRunner(tn.ri.Namespace, tn.ri.GroupName, tn.step.RunnerName, tn.step.Name, 0)
 				}
 			}()
 		}
 	case types.CompleteStep:
 		// CompleteStep must be sent from the Runner in the Scheduler handler.
 		res, err = s.handleCompleteStep(req.Data)
+	case types.CancelStep:
+		// CancelStep must be sent from the Dashboard in the Scheduler handler.
+		// And then the command would be transmitted to the specific Runner which was running the Step.
+		res, err = s.handleCancelStep(req.Data, o)
+	case types.RunPipeline:
+		// RunPipeline must be sent from the Dashboard in the Scheduler handler.
+		res, err = s.handleRunPipeline(req.Data, o)
+	case types.GetPipeline:
+		res, err = s.handleGetPipeline(req.Data)
+	case types.ListSchedules:
+		res, err = s.handleListSchedules(req.Data)
+	case types.CreateSchedule:
+		res, err = s.handleCreateSchedule(req.Data)
+	case types.PauseSchedule:
+		res, err = s.handlePauseSchedule(req.Data)
+	case types.DeleteSchedule:
+		res, err = s.handleDeleteSchedule(req.Data)
+	case types.CreateNamespace:
+		res, err = s.handleCreateNamespace(req.Data, token, o)
+	case types.RenameNamespace:
+		res, err = s.handleRenameNamespace(req.Data, token, o)
+	case types.DeleteNamespace:
+		res, err = s.handleDeleteNamespace(req.Data, token, o)
+	case types.CreateGroup:
+		res, err = s.handleCreateGroup(req.Data, token, o)
+	case types.RenameGroup:
+		res, err = s.handleRenameGroup(req.Data, token, o)
+	case types.DeleteGroup:
+		res, err = s.handleDeleteGroup(req.Data, token, o)
+	case types.ListPendingRunners:
+		res, err = s.handleListPendingRunners(req.Data)
+	case types.ListRunnerQueue:
+		res, err = s.handleListRunnerQueue(req.Data)
+	case types.CancelQueuedStep:
+		res, err = s.handleCancelQueuedStep(req.Data, o)
+	case types.MoveQueuedStep:
+		res, err = s.handleMoveQueuedStep(req.Data, o)
+	case types.ApproveStep:
+		res, err = s.handleApproveStep(req.Data, token, o)
+	case types.ListLocks:
+		res, err = s.handleListLocks(req.Data)
+	case types.Subscribe:
+		res, err = s.handleSubscribe(req.Data, clientId)
+	case types.Unsubscribe:
+		res, err = s.handleUnsubscribe(req.Data, clientId)
 	case types.LogStream:
 		// LogStream must be sent from the Runner in the Scheduler handler.
 		// The output should be inserted into mysql, and sent to all dashboard at the same time
@@ -109,34 +266,39 @@ func (s *Scheduler) handle(message []byte, clientId int32) (res []byte, err erro
 	case types.ServiceAPIListVersionsRequest:
 		reqType.ServiceAPI = types.ServiceAPIListVersionsResponse
 		res, err = s.handleListRecordsRequest(req.Data)
+	default:
+		err = newError(types.CodeInvalid, ErrServiceAPIWasInvalid, req.Type.ServiceAPI)
 	}
 	if err != nil {
 		klog.V(2).Info(err)
-		//todo handle error
-		return nil, err
+		return errorResponse(reqType, o, err).Marshal()
 	}
-	// todo remove
-	switch req.Type.ServiceAPI {
-	case types.RegisterRunner:
-	case types.UpdateStep:
-		if len(res) == 0 {
-			return res, nil
-		}
-	default:
+	// the Runner would apply the Step of the UpdateStep, so the empty reply wouldn't be sent to it
+	if req.Type.ServiceAPI == types.UpdateStep && body == types.BodyRunner && len(res) == 0 {
+		return res, nil
 	}
-	result := &types.Request{
-		Type: reqType,
-		Data: res,
+	result := &types.Response{
+		Code:      types.CodeOK,
+		Type:      reqType,
+		Data:      res,
+		Id:        o.id,
+		Timestamp: o.timestamp,
 	}
 	return result.Marshal()
 }
 
+// origin was the Id and the Timestamp of the Request, which would be echoed in the Responses resulting from it.
+// It was empty if the Responses resulted from the Scheduler itself, such as the Schedules and the timeouts.
+type origin struct {
+	id        string
+	timestamp int64
+}
+
 func (s *Scheduler) handlePing(data []byte, clientId int32) (res []byte, err error) {
 	s.broadcast <- &broadcast{
-		bt:         broadcastTypePing,
-		clientId:   clientId,
-		runnerName: "",
-		msg:        res,
+		bt:       broadcastTypePing,
+		clientId: clientId,
+		msg:      res,
 	}
 	t := &types.PongResponse{}
 	return t.Marshal()
@@ -144,9 +306,11 @@ func (s *Scheduler) handlePing(data []byte, clientId int32) (res []byte, err err
 
 func (s *Scheduler) handleListNamespaces(data []byte) (res []byte, err error) {
 	keys := make([]string, 0)
+	s.mu.Lock()
 	for k := range s.items {
 		keys = append(keys, string(k))
 	}
+	s.mu.Unlock()
 	sort.Strings(keys)
 	result := &types.ListNamespaceResponse{
 		Items: keys,
@@ -158,19 +322,19 @@ func (s *Scheduler) handleListGroupNames(data []byte) (res []byte, err error) {
 	req := &types.ListGroupNameRequest{}
 	if err = req.Unmarshal(data); err != nil {
 		klog.V(2).Info(err)
-		return nil, err
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
 	}
 	result := &types.ListGroupNameResponse{
 		Items: make([]string, 0),
 	}
+	s.mu.Lock()
 	if t, ok := s.items[req.Namespace]; ok {
-		keys := make([]string, 0)
 		for k := range t.items {
-			keys = append(keys, string(k))
+			result.Items = append(result.Items, string(k))
 		}
-		sort.Strings(keys)
-		result.Items = keys
 	}
+	s.mu.Unlock()
+	sort.Strings(result.Items)
 	return result.Marshal()
 }
 
@@ -178,7 +342,7 @@ func (s *Scheduler) handleListRunners(data []byte) (res []byte, err error) {
 	req := &types.ListRunnerRequest{}
 	if err = req.Unmarshal(data); err != nil {
 		klog.V(2).Info(err)
-		return nil, err
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
 	}
 	var g *Group
 	if g, err = s.getGroup(req.Namespace, req.GroupName); err != nil {
@@ -203,112 +367,218 @@ func (s *Scheduler) handleRegisterRunner(data []byte, clientId int32) (res []byt
 	req := &types.RegisterRunnerRequest{}
 	if err = req.Unmarshal(data); err != nil {
 		klog.V(2).Info(err)
-		return nil, err
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
 	}
-	var g *Group
-	if g, err = s.getGroup(req.RunnerInfo.Namespace, req.RunnerInfo.GroupName); err != nil {
+	if err = s.registerRunner(&req.RunnerInfo, clientId); err != nil {
 		klog.V(2).Info(err)
 		return nil, err
 	}
+	result := &types.RegisterRunnerResponse{}
+	return result.Marshal()
+}
+
+// registerRunner binds the Runner to the connection, the Runner would be pending if its group was not existed
+func (s *Scheduler) registerRunner(ri *types.RunnerInfo, clientId int32) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-	if _, ok := g.Runners[req.RunnerInfo.Name]; !ok {
-		g.Runners[req.RunnerInfo.Name] = &req.RunnerInfo
-		g.Ids[clientId] = req.RunnerInfo.Name
+	g, err := s.group(ri.Namespace, ri.GroupName)
+	if err != nil {
+		// the Runner would be registered after the group was created
+		s.pending[clientId] = ri
+		s.mu.Unlock()
+		return newError(types.CodeNotFound, ErrRunnerWasPending, ri.Namespace, ri.GroupName, ri.Name)
 	}
-	s.broadcast <- &broadcast{
-		bt:         broadcastTypeBindRunner,
-		clientId:   clientId,
-		runnerName: req.RunnerInfo.Name,
-		msg:        res,
+	if g.retired {
+		s.mu.Unlock()
+		return newError(types.CodeNotFound, ErrGroupWasRetired, ri.Namespace, ri.GroupName)
 	}
-	result := &types.RegisterRunnerResponse{}
-	return result.Marshal()
+	s.mu.Unlock()
+	// the settings edited by the dashboards would survive the restarts of the Scheduler and the Runner
+	s.loadRunner(ri)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := g.Runners[ri.Name]; ok {
+		return s.registerDuplicate(g, old, ri, clientId)
+	}
+	g.Runners[ri.Name] = ri
+	g.addRunner(ri)
+	g.addSession(ri.Name, clientId)
+	s.persistRunner(ri)
+	return nil
 }
 
 const (
-	ErrNamespaceWasNotExisted = "error: namespace:%s was not existed"
-	ErrGroupWasNotExisted     = "error: namespace:%s groupName:%s was not existed"
-	ErrRunnerWasNotExisted    = "error: namespace:%s groupName:%s runner:%s was not existed"
-	ErrStepWasNotExisted      = "error: namespace:%s groupName:%s runner:%s step:%s was not existed"
+	ErrNamespaceWasNotExisted  = "error: namespace:%s was not existed"
+	ErrGroupWasNotExisted      = "error: namespace:%s groupName:%s was not existed"
+	ErrRunnerWasNotExisted     = "error: namespace:%s groupName:%s runner:%s was not existed"
+	ErrStepWasNotExisted       = "error: namespace:%s groupName:%s runner:%s step:%s was not existed"
+	ErrStepWasNotRunning       = "error: namespace:%s groupName:%s runner:%s step:%s was not running"
+	ErrRecordVersionWasInvalid = "error: records version:%d was invalid"
+	ErrRecordsPageWasInvalid   = "error: records page:%d length:%d was invalid"
 )
 
 func (s *Scheduler) getGroup(namespace types.Namespace, groupName types.GroupName) (*Group, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.group(namespace, groupName)
+}
+
+// group was the getGroup when the s.mu was held
+func (s *Scheduler) group(namespace types.Namespace, groupName types.GroupName) (*Group, error) {
 	if t, ok := s.items[namespace]; ok {
 		if t2, ok := t.items[groupName]; ok {
 			return t2, nil
 		} else {
-			return nil, fmt.Errorf(ErrGroupWasNotExisted, namespace, groupName)
+			return nil, newError(types.CodeNotFound, ErrGroupWasNotExisted, namespace, groupName)
 		}
 	} else {
-		return nil, fmt.Errorf(ErrNamespaceWasNotExisted, namespace)
+		return nil, newError(types.CodeNotFound, ErrNamespaceWasNotExisted, namespace)
 	}
 }
 
-func (s *Scheduler) handleRunStep(data []byte) (res []byte, err error) {
+func (s *Scheduler) handleRunStep(data []byte, o origin) (res []byte, err error) {
 	req := &types.RunStepRequest{}
 	if err = req.Unmarshal(data); err != nil {
 		klog.V(2).Info(err)
-		return nil, err
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
 	}
+	return s.runStep(req, o, true, false)
+}
+
+// runStep sends the Step to the Runner and syncs the phases to all dashboards.
+// If the queueable was true and the specified Runner was busy, the request would be queued until the Runner was released.
+// The queueable was false for the requests which were dequeued or triggered automatically, the Runner had been kept busy for them.
+// The gated Step would await the approvals unless the approved was true, which means that it had been approved before.
+func (s *Scheduler) runStep(req *types.RunStepRequest, o origin, queueable, approved bool) (res []byte, err error) {
 	var g *Group
 	if g, err = s.getGroup(req.Namespace, req.GroupName); err != nil {
 		klog.V(2).Info(err)
 		return nil, err
 	}
+	// the Runner would be picked by the Selector when the dashboard didn't specify it
+	picked := req.RunnerName == ""
+	if picked {
+		var queued *types.QueuedStep
+		if req.RunnerName, queued, err = s.selectRunner(g, req); err != nil {
+			klog.V(2).Info(err)
+			return nil, err
+		}
+		// the Position of the Item was in the queue of the pool group
+		if queued != nil {
+			result := &types.RunStepResponse{
+				Queued: true,
+				Item:   *queued,
+			}
+			return result.Marshal()
+		}
+		req.Step.RunnerName = req.RunnerName
+		queueable = false
+	}
 	s.mu.Lock()
 	var ri *types.RunnerInfo
 	if t, ok := g.Runners[req.RunnerName]; !ok {
 		s.mu.Unlock()
-		return nil, fmt.Errorf(ErrRunnerWasNotExisted, req.Namespace, req.GroupName, req.RunnerName)
+		return nil, newError(types.CodeNotFound, ErrRunnerWasNotExisted, req.Namespace, req.GroupName, req.RunnerName)
 	} else {
-		s.mu.Unlock()
 		ri = t
 	}
+	var declared *types.Step
+	for i, v := range ri.Steps {
+		if v.Name == req.Step.Name {
+			declared = &ri.Steps[i]
+		}
+	}
+	if declared == nil {
+		s.mu.Unlock()
+		return nil, newError(types.CodeNotFound, ErrStepWasNotExisted, req.Namespace, req.GroupName, req.RunnerName, req.Step.Name)
+	}
+	if required := g.approvals[req.Step.Name]; required > 0 && !approved {
+		res, err = s.awaitApproval(g, ri, req, required, o)
+		s.mu.Unlock()
+		// the Runner which was kept busy for the request would be released while the Step was awaiting,
+		// the callers release it on the errors except the picked one
+		if !queueable && (err == nil || picked) {
+			go s.releaseRunner(req.Namespace, req.GroupName, req.RunnerName, req.Step.Name, 0)
+		}
+		return res, err
+	}
+	if !approved {
+		req.Step.Approvals = nil
+	}
+	if r, ok := g.pool[req.RunnerName]; queueable && ok && r.status != Idle {
+		q := g.enqueueStep(req)
+		klog.Infof("queue step:%s runner:%s namespace:%s groupName:%s position:%d", req.Step.Name, req.RunnerName, req.Namespace, req.GroupName, q.Position)
+		s.queueToDashboard(g, req.Namespace, req.GroupName, req.RunnerName, o)
+		s.mu.Unlock()
+		result := &types.RunStepResponse{
+			Queued: true,
+			Item:   q,
+		}
+		return result.Marshal()
+	}
+	// the locks were declared by the Runner, the ones in the request would be ignored
+	if held := s.acquireLocks(req, declared.Locks); held != "" {
+		res, err = s.awaitLock(g, ri, req, held, declared.LockPolicy, o)
+		s.mu.Unlock()
+		// the callers release the Runner on the errors except the picked one
+		if err != nil && picked {
+			go s.releaseRunner(req.Namespace, req.GroupName, req.RunnerName, req.Step.Name, 0)
+		}
+		return res, err
+	}
+	// the Runner was marked as Running before it was released by the other requests, the Step would be sent at the first attempt
+	g.markRunning(req.RunnerName, req.Step.Name, 1)
 	klog.Info("handleRunStep name:", req.Step.Name)
 	exist := false
-	newSteps := make([]types.Step, 0)
+	newSteps := make([]types.Step, 0, len(ri.Steps))
+	updates := make([]*types.Step, 0, len(ri.Steps))
 	var waitStep *types.Step
 	for _, v := range ri.Steps {
 		if v.Name == req.Step.Name {
 			exist = true
+			locks, lockPolicy := v.Locks, v.LockPolicy
 			v = *req.Step.DeepCopy()
+			v.Locks, v.LockPolicy = locks, lockPolicy
 			v.Phase = types.StepRunning
+			// the retries would increase the Attempt without calling the runStep
+			v.Attempt = 1
+			v.Reason = ""
 			// collecting sharing data
 			if v.SharingSetting == true {
 				klog.Info("trigger collectSharingData name:", v.Name)
 				s.collectSharingData(g, req.RunnerName, &v)
 			}
 			waitStep = v.DeepCopy()
-			// sync for updating
-			if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, &v); err != nil {
-				klog.V(2).Info(err)
-				return nil, err
-			}
+			updates = append(updates, v.DeepCopy())
 		}
 		if exist && v.Name != req.Step.Name {
 			// if the exist was true, it would change all the steps' phases to Pending
 			if v.Phase != types.StepPending {
 				v.Phase = types.StepPending
-				if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, v.DeepCopy()); err != nil {
-					klog.V(2).Info(err)
-					return nil, err
-				}
+				updates = append(updates, v.DeepCopy())
 			}
 		}
 		newSteps = append(newSteps, v)
 	}
-	if exist {
-		ri.Steps = newSteps
-		// run the step which waited before
-		go func() {
-			if err = s.runStepToRunner(req.Namespace, req.GroupName, req.RunnerName, waitStep); err != nil {
-				klog.V(2).Info(err)
+	ri.Steps = newSteps
+	s.persistRunner(ri)
+	s.mu.Unlock()
+	// sync for updating
+	for _, v := range updates {
+		if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, v, o); err != nil {
+			klog.V(2).Info(err)
+			// the Step wasn't sent, the callers release the Runner on the errors except the queueable and the picked one
+			if queueable || picked {
+				go s.releaseRunner(req.Namespace, req.GroupName, req.RunnerName, req.Step.Name, 1)
 			}
-		}()
-	} else {
-		return nil, fmt.Errorf(ErrStepWasNotExisted, req.Namespace, req.GroupName, req.RunnerName, req.Step.Name)
+			return nil, err
+		}
 	}
+	// run the step which waited before
+	go func() {
+		if err := s.runStepToRunner(req.Namespace, req.GroupName, req.RunnerName, waitStep, o); err != nil {
+			klog.V(2).Info(err)
+		}
+	}()
 	return res, nil
 }
 
@@ -337,7 +607,7 @@ type triggerNext struct {
 	step *types.Step
 }
 
-func (s *Scheduler) handleUpdateStep(data []byte, body types.Body) (res []byte, tn *triggerNext, err error) {
+func (s *Scheduler) handleUpdateStep(data []byte, body types.Body, o origin) (res []byte, tn *triggerNext, err error) {
 	tn = &triggerNext{
 		next: false,
 		ri:   &types.RunnerInfo{},
@@ -346,7 +616,7 @@ func (s *Scheduler) handleUpdateStep(data []byte, body types.Body) (res []byte,
 	req := &types.RunStepRequest{}
 	if err = req.Unmarshal(data); err != nil {
 		klog.V(2).Info(err)
-		return nil, tn, err
+		return nil, tn, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
 	}
 	var g *Group
 	if g, err = s.getGroup(req.Namespace, req.GroupName); err != nil {
@@ -354,35 +624,26 @@ func (s *Scheduler) handleUpdateStep(data []byte, body types.Body) (res []byte,
 		return nil, tn, err
 	}
 	s.mu.Lock()
-	var ri *types.RunnerInfo
-	if t, ok := g.Runners[req.RunnerName]; !ok {
-		s.mu.Unlock()
-		return nil, tn, fmt.Errorf(ErrRunnerWasNotExisted, req.Namespace, req.GroupName, req.RunnerName)
-	} else {
+	ri, ok := g.Runners[req.RunnerName]
+	if !ok {
 		s.mu.Unlock()
-		ri = t
+		return nil, tn, newError(types.CodeNotFound, ErrRunnerWasNotExisted, req.Namespace, req.GroupName, req.RunnerName)
 	}
 	exist := false
 	next := false
-	newSteps := make([]types.Step, 0)
+	var updated *types.Step
+	newSteps := make([]types.Step, 0, len(ri.Steps))
 	for _, v := range ri.Steps {
 		switch next {
 		case false:
 			if v.Name == req.Step.Name {
 				exist = true
 				v = req.Step
-				// save to db
-				if body == types.BodyRunner {
-					go s.recordStep(ri, v.DeepCopy())
-				}
-				// sync for updating
-				if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, &v); err != nil {
-					klog.V(2).Info(err)
-					return nil, tn, err
-				}
+				updated = v.DeepCopy()
 				// if the request body was types.BodyRunner and the step.Phase was the types.StepSucceeded,
-				// it means that the Scheduler should trigger automatic running
-				if body == types.BodyRunner && v.Phase == types.StepSucceeded {
+				// it means that the Scheduler should trigger automatic running.
+				// The pipeline would trigger the next Steps instead if it was declared.
+				if body == types.BodyRunner && v.Phase == types.StepSucceeded && g.pipeline == nil {
 					next = true
 				}
 			}
@@ -402,9 +663,39 @@ func (s *Scheduler) handleUpdateStep(data []byte, body types.Body) (res []byte,
 		}
 		newSteps = append(newSteps, v)
 	}
-	ri.Steps = newSteps
 	if !exist {
-		return nil, tn, fmt.Errorf(ErrStepWasNotExisted, req.Namespace, req.GroupName, req.RunnerName, req.Step.Name)
+		s.mu.Unlock()
+		return nil, tn, newError(types.CodeNotFound, ErrStepWasNotExisted, req.Namespace, req.GroupName, req.RunnerName, req.Step.Name)
+	}
+	ri.Steps = newSteps
+	s.persistRunner(ri)
+	// the next Step would be triggered only if the Runner was still kept busy by the reported one
+	if tn.next {
+		tn.next = g.handOver(req.RunnerName, req.Step.Name, req.Step.Attempt, tn.step.Name)
+	}
+	pipelined := g.pipeline != nil
+	s.mu.Unlock()
+	// save to db
+	if body == types.BodyRunner {
+		s.watchdog.stop(stepKey(req.Namespace, req.GroupName, req.RunnerName, updated.Name))
+		go s.recordStep(ri, updated.DeepCopy())
+	}
+	// sync for updating, the Step had been updated so the failed sync wouldn't stop the terminating
+	if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, updated, o); err != nil {
+		klog.V(2).Info(err)
+	}
+	// the failed Step which would be retried wasn't terminated yet
+	retrying := body == types.BodyRunner && s.scheduleRetry(g, req.Namespace, req.GroupName, req.RunnerName, &req.Step)
+	if body == types.BodyRunner && isTerminated(req.Step.Phase) && !retrying {
+		go s.releaseLocks(req.Namespace, req.GroupName, req.RunnerName, req.Step.Name)
+		go s.completeSchedules(req.Namespace, req.GroupName, req.RunnerName, req.Step.Name, req.Step.Phase)
+		if pipelined {
+			go s.advancePipeline(g, req.RunnerName, req.Step.Name, req.Step.Phase)
+		}
+	}
+	// the Runner keeps busy if the next Step would be triggered automatically or the Step would be retried
+	if body == types.BodyRunner && isTerminated(req.Step.Phase) && !tn.next && !retrying {
+		go s.releaseRunner(req.Namespace, req.GroupName, req.RunnerName, req.Step.Name, req.Step.Attempt)
 	}
 	return res, tn, nil
 }
@@ -413,7 +704,7 @@ func (s *Scheduler) handleCompleteStep(data []byte) (res []byte, err error) {
 	req := &types.RunStepRequest{}
 	if err = req.Unmarshal(data); err != nil {
 		klog.V(2).Info(err)
-		return nil, err
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
 	}
 	var g *Group
 	if g, err = s.getGroup(req.Namespace, req.GroupName); err != nil {
@@ -424,7 +715,7 @@ func (s *Scheduler) handleCompleteStep(data []byte) (res []byte, err error) {
 	var ri *types.RunnerInfo
 	if t, ok := g.Runners[req.RunnerName]; !ok {
 		s.mu.Unlock()
-		return nil, fmt.Errorf(ErrRunnerWasNotExisted, req.Namespace, req.GroupName, req.RunnerName)
+		return nil, newError(types.CodeNotFound, ErrRunnerWasNotExisted, req.Namespace, req.GroupName, req.RunnerName)
 	} else {
 		s.mu.Unlock()
 		ri = t
@@ -437,7 +728,7 @@ func (s *Scheduler) handleCompleteStep(data []byte) (res []byte, err error) {
 			// send to the Runner, and then sync to all dashboards for updating Runner status
 			v = req.Step
 			// sync for updating
-			if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, &v); err != nil {
+			if err = s.updateStepToDashboard(req.Namespace, req.GroupName, req.RunnerName, &v, origin{}); err != nil {
 				klog.V(2).Info(err)
 				return nil, err
 			}
@@ -449,12 +740,82 @@ func (s *Scheduler) handleCompleteStep(data []byte) (res []byte, err error) {
 	}
 	ri.Steps = newSteps
 	if !exist {
-		return nil, fmt.Errorf(ErrStepWasNotExisted, req.Namespace, req.GroupName, req.RunnerName, req.Step.Name)
+		return nil, newError(types.CodeNotFound, ErrStepWasNotExisted, req.Namespace, req.GroupName, req.RunnerName, req.Step.Name)
+	}
+	s.persistRunner(ri)
+	return res, nil
+}
+
+func (s *Scheduler) handleCancelStep(data []byte, o origin) (res []byte, err error) {
+	req := &types.CancelStepRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	var g *Group
+	if g, err = s.getGroup(req.Namespace, req.GroupName); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	s.mu.Lock()
+	var ri *types.RunnerInfo
+	if t, ok := g.Runners[req.RunnerName]; !ok {
+		s.mu.Unlock()
+		return nil, newError(types.CodeNotFound, ErrRunnerWasNotExisted, req.Namespace, req.GroupName, req.RunnerName)
+	} else {
+		s.mu.Unlock()
+		ri = t
+	}
+	// the Step which was waiting for the approvals, the lock or the retry would be terminated by the Scheduler itself
+	if s.cancelApproval(g, ri, req.StepName) || s.cancelLockWait(g, ri, req.StepName) || s.cancelRetry(g, ri, req.StepName) {
+		result := &types.CancelStepResponse{}
+		return result.Marshal()
+	}
+	exist := false
+	for _, v := range ri.Steps {
+		if v.Name == req.StepName {
+			exist = true
+			if v.Phase != types.StepRunning {
+				return nil, newError(types.CodeBusy, ErrStepWasNotRunning, req.Namespace, req.GroupName, req.RunnerName, req.StepName)
+			}
+		}
+	}
+	if !exist {
+		return nil, newError(types.CodeNotFound, ErrStepWasNotExisted, req.Namespace, req.GroupName, req.RunnerName, req.StepName)
+	}
+	klog.Info("handleCancelStep name:", req.StepName)
+	req2 := &types.Response{
+		Type: types.Type{
+			ServiceAPI: types.CancelStep,
+		},
+		Data:      data,
+		Id:        o.id,
+		Timestamp: o.timestamp,
+		Broadcast: true,
+	}
+	data2, err := req2.Marshal()
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	// the Runner would report the failed Step by the UpdateStep, and then it would be synced to all dashboards.
+	// The Step was cancelled by all the connections of the Runner, the ones which weren't running it would ignore it.
+	ids, err := s.sessionsOf(req.Namespace, req.GroupName, req.RunnerName)
+	if err != nil {
+		klog.V(2).Info(err)
+		return nil, err
+	}
+	for _, id := range ids {
+		s.broadcast <- &broadcast{
+			bt:       broadcastTypeRunner,
+			clientId: id,
+			msg:      data2,
+		}
 	}
 	return res, nil
 }
 
-func (s *Scheduler) runStepToRunner(namespace types.Namespace, groupName types.GroupName, runnerName string, step *types.Step) (err error) {
+func (s *Scheduler) runStepToRunner(namespace types.Namespace, groupName types.GroupName, runnerName string, step *types.Step, o origin) (err error) {
 	req1 := &types.RunStepRequest{
 		Namespace:  namespace,
 		GroupName:  groupName,
@@ -466,26 +827,41 @@ func (s *Scheduler) runStepToRunner(namespace types.Namespace, groupName types.G
 		klog.V(2).Info(err)
 		return err
 	}
-	req2 := &types.Request{
+	req2 := &types.Response{
 		Type: types.Type{
 			ServiceAPI: types.RunStep,
 		},
-		Data: data1,
+		Data:      data1,
+		Id:        o.id,
+		Timestamp: o.timestamp,
+		Broadcast: true,
 	}
 	data2, err := req2.Marshal()
 	if err != nil {
 		klog.V(2).Info(err)
 		return err
 	}
+	id, err := s.nextSession(namespace, groupName, runnerName)
+	if err != nil {
+		klog.V(2).Info(err)
+		return err
+	}
 	s.broadcast <- &broadcast{
-		bt:         broadcastTypeRunner,
-		runnerName: runnerName,
-		msg:        data2,
+		bt:       broadcastTypeRunner,
+		clientId: id,
+		msg:      data2,
+	}
+	// the Step would be marked as StepUnknown if the Runner didn't report it before the deadline
+	if timeout := step.Timeout(); timeout > 0 {
+		stepName := step.Name
+		s.watchdog.watch(stepKey(namespace, groupName, runnerName, stepName), timeout+StepTimeoutGracePeriod, func() {
+			s.expireStep(namespace, groupName, runnerName, stepName)
+		})
 	}
 	return nil
 }
 
-func (s *Scheduler) updateStepToDashboard(namespace types.Namespace, groupName types.GroupName, runnerName string, step *types.Step) (err error) {
+func (s *Scheduler) updateStepToDashboard(namespace types.Namespace, groupName types.GroupName, runnerName string, step *types.Step, o origin) (err error) {
 	req := &types.UpdateStepRequest{
 		Namespace:  namespace,
 		GroupName:  groupName,
@@ -497,11 +873,14 @@ func (s *Scheduler) updateStepToDashboard(namespace types.Namespace, groupName t
 		klog.V(2).Info(err)
 		return err
 	}
-	req2 := &types.Request{
+	req2 := &types.Response{
 		Type: types.Type{
 			ServiceAPI: types.UpdateStep,
 		},
-		Data: data,
+		Data:      data,
+		Id:        o.id,
+		Timestamp: o.timestamp,
+		Broadcast: true,
 	}
 	data2, err := req2.Marshal()
 	if err != nil {
@@ -509,9 +888,14 @@ func (s *Scheduler) updateStepToDashboard(namespace types.Namespace, groupName t
 		return err
 	}
 	s.broadcast <- &broadcast{
-		bt:         broadcastTypeDashboard,
-		runnerName: "",
-		msg:        data2,
+		bt:  broadcastTypeDashboard,
+		msg: data2,
+		scope: &types.Subscription{
+			Namespace:  namespace,
+			GroupName:  groupName,
+			RunnerName: runnerName,
+			StepName:   step.Name,
+		},
 	}
 	return nil
 }
@@ -519,17 +903,27 @@ func (s *Scheduler) updateStepToDashboard(namespace types.Namespace, groupName t
 func (s *Scheduler) handleLogStream(data []byte) (res []byte, err error) {
 	req := &types.LogStreamRequest{}
 	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	res, err = (&types.LogStreamResponse{Seq: req.Seq}).Marshal()
+	if err != nil {
 		klog.V(2).Info(err)
 		return nil, err
 	}
+	if !s.acceptLogSeq(req) {
+		// the line had been received before the Runner replayed it
+		return res, nil
+	}
 	// todo insert into the db or runtime cache
 
-	// broadcast to all dashboards
-	req2 := &types.Request{
+	// broadcast to the dashboards which subscribed the logs
+	req2 := &types.Response{
 		Type: types.Type{
 			ServiceAPI: types.LogStream,
 		},
-		Data: data,
+		Data:      data,
+		Broadcast: true,
 	}
 	data2, err := req2.Marshal()
 	if err != nil {
@@ -537,14 +931,43 @@ func (s *Scheduler) handleLogStream(data []byte) (res []byte, err error) {
 		return nil, err
 	}
 	s.broadcast <- &broadcast{
-		bt:         broadcastTypeDashboard,
-		runnerName: "",
-		msg:        data2,
+		bt:  broadcastTypeDashboard,
+		msg: data2,
+		scope: &types.Subscription{
+			Namespace:  req.Namespace,
+			GroupName:  req.GroupName,
+			RunnerName: req.RunnerName,
+			StepName:   req.StepName,
+			Logs:       true,
+		},
 	}
 	return res, nil
 }
 
-func (s *Scheduler) triggerRunStep(ri *types.RunnerInfo, step *types.Step) (res []byte, err error) {
+// acceptLogSeq reports whether the line was not received before.
+// The line without a Seq was always accepted, and the Seq 1 means the Runner started with an empty spool.
+// The lines of the DuplicatePolicyPool were always accepted as well, because each connection of the same name
+// spooled its own Seq, and the replayed ones might be received twice after reconnecting.
+func (s *Scheduler) acceptLogSeq(req *types.LogStreamRequest) bool {
+	if req.Seq == 0 {
+		return true
+	}
+	key := fmt.Sprintf("%s/%s/%s", req.Namespace, req.GroupName, req.RunnerName)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if g, err := s.group(req.Namespace, req.GroupName); err == nil && g.duplicate == DuplicatePolicyPool {
+		return true
+	}
+	if last, ok := s.logSeqs[key]; ok && req.Seq <= last && req.Seq != 1 {
+		return false
+	}
+	s.logSeqs[key] = req.Seq
+	return true
+}
+
+// triggerRunStep runs the Step on the Runner, the queueable was false for the Step which was triggered automatically
+// after the previous one of the same Runner
+func (s *Scheduler) triggerRunStep(ri *types.RunnerInfo, step *types.Step, o origin, queueable bool) (res []byte, err error) {
 	klog.Info("triggerRunStep name:", step.Name)
 	req := &types.RunStepRequest{
 		Namespace:  ri.Namespace,
@@ -552,12 +975,7 @@ func (s *Scheduler) triggerRunStep(ri *types.RunnerInfo, step *types.Step) (res
 		RunnerName: step.RunnerName,
 		Step:       *step,
 	}
-	data, err := req.Marshal()
-	if err != nil {
-		klog.V(2).Info(err)
-		return nil, err
-	}
-	return s.handleRunStep(data)
+	return s.runStep(req, o, queueable, false)
 }
 
 func (s *Scheduler) recordStep(ri *types.RunnerInfo, step *types.Step) {
@@ -598,31 +1016,43 @@ func getStepType(s *types.Step) int {
 	return types.RecordVersion
 }
 
+// recordsFilter returns the conditions of the records in the sql where and their args,
+// the RunnerName was optional and the IsVersion must be one of the RecordDefault and the RecordVersion.
+// The Page must not be negative and the Length must be in [0, RecordsMaxLength]
+func recordsFilter(req *types.ListRecordsRequest) (where string, args []interface{}, err error) {
+	if req.Page < 0 || req.Length < 0 || req.Length > types.RecordsMaxLength {
+		return "", nil, newError(types.CodeInvalid, ErrRecordsPageWasInvalid, req.Page, req.Length)
+	}
+	conditions := []string{"`namespace` = ?", "`groupName` = ?"}
+	args = []interface{}{req.Namespace, req.GroupName}
+	if req.RunnerName != "" {
+		conditions = append(conditions, "`runnerName` = ?")
+		args = append(args, req.RunnerName)
+	}
+	switch req.IsVersion {
+	case types.RecordDefault:
+	case types.RecordVersion:
+		conditions = append(conditions, "`stepType` = ?")
+		args = append(args, req.IsVersion)
+	default:
+		return "", nil, newError(types.CodeInvalid, ErrRecordVersionWasInvalid, req.IsVersion)
+	}
+	return strings.Join(conditions, " AND "), args, nil
+}
+
 func (s *Scheduler) handleListRecordsRequest(data []byte) (res []byte, err error) {
 	req := &types.ListRecordsRequest{}
 	if err := req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	where, args, err := recordsFilter(req)
+	if err != nil {
 		klog.V(2).Info(err)
 		return nil, err
 	}
 	db := s.dao.Mysql.Master()
-	var (
-		rows *sql.Rows
-	)
-	switch req.IsVersion {
-	case types.RecordDefault:
-		rows, err = db.Query("SELECT * FROM records WHERE `namespace` = ? AND `groupName` = ? ORDER BY id DESC LIMIT ?, ?",
-			req.Namespace,
-			req.GroupName,
-			req.Page,
-			req.Length)
-	case types.RecordVersion:
-		rows, err = db.Query("SELECT * FROM records WHERE `namespace` = ? AND `groupName` = ? AND `stepType` = ? ORDER BY id DESC LIMIT ?, ?",
-			req.Namespace,
-			req.GroupName,
-			req.IsVersion,
-			req.Page,
-			req.Length)
-	}
+	rows, err := db.Query("SELECT * FROM records WHERE "+where+" ORDER BY id DESC LIMIT ?, ?", append(args, req.Page, req.Length)...)
 	if err != nil {
 		klog.V(2).Info(err)
 		return nil, err
@@ -637,17 +1067,9 @@ func (s *Scheduler) handleListRecordsRequest(data []byte) (res []byte, err error
 		records = append(records, *record)
 	}
 	var num int
-	switch req.IsVersion {
-	case types.RecordDefault:
-		if err = db.QueryRow("SELECT count(*) FROM records").Scan(&num); err != nil {
-			klog.V(2).Info(err)
-			return nil, err
-		}
-	case types.RecordVersion:
-		if err = db.QueryRow("SELECT count(*) FROM records WHERE `stepType` = ?", req.IsVersion).Scan(&num); err != nil {
-			klog.V(2).Info(err)
-			return nil, err
-		}
+	if err = db.QueryRow("SELECT count(*) FROM records WHERE "+where, args...).Scan(&num); err != nil {
+		klog.V(2).Info(err)
+		return nil, err
 	}
 	response := &types.ListRecordsResponse{
 		Params:       *req,
@@ -1 +1,321 @@
-package scheduler
\ No newline at end of file
+package scheduler
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestScheduler_handle(t *testing.T) {
+	tests := []struct {
+		name  string
+		phase types.StepPhase
+	}{
+		{
+			name:  "TestScheduler_handle_1",
+			phase: types.StepFailed,
+		},
+		{
+			name:  "TestScheduler_handle_2",
+			phase: types.StepSucceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				items:     map[types.Namespace]*Groups{"ns1": newGroups(true)},
+				broadcast: make(chan *broadcast, 10),
+				watchdog:  newWatchdog(),
+				retries:   newWatchdog(),
+				states:    newPendingStates(),
+			}
+			g := newGroup(GroupModeDefault, "", true, nil, nil)
+			s.items["ns1"].items["g1"] = g
+			ri := &types.RunnerInfo{
+				Name:      "r1",
+				Namespace: "ns1",
+				GroupName: "g1",
+				Steps: []types.Step{
+					{Name: "build", Phase: types.StepRunning, Attempt: 1, Retry: types.RetryPolicy{MaxAttempts: 3, BackoffInSec: 60}},
+					{Name: "upload", Phase: types.StepPending, Policy: types.StepPolicyAuto},
+				},
+			}
+			g.Runners[ri.Name] = ri
+			g.addRunner(ri)
+			g.markRunning(ri.Name, "build", 1)
+			step := ri.Steps[0]
+			step.Phase = tt.phase
+			data, err := (&types.UpdateStepRequest{Namespace: "ns1", GroupName: "g1", RunnerName: "r1", Step: step}).Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the dashboard pretends to be the Runner by the Body of the Type
+			message, err := (&types.Request{Type: types.Type{Body: types.BodyRunner, ServiceAPI: types.UpdateStep}, Data: data}).Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := s.handle(message, 1, types.BodyDashboard, "")
+			if err != nil {
+				t.Fatalf("handle() error = %v", err)
+			}
+			got := &types.Response{}
+			if err = got.Unmarshal(res); err != nil || got.Code != types.CodeOK {
+				t.Fatalf("handle() response = %v, error = %v", got, err)
+			}
+			if s.retries.stop(stepKey("ns1", "g1", "r1", step.Name)) {
+				t.Errorf("handle() the retry was scheduled by the dashboard")
+			}
+			if r := g.pool[ri.Name]; r.status != Running {
+				t.Errorf("handle() the Runner was released by the dashboard")
+			}
+			if ri.Steps[1].Phase != types.StepPending {
+				t.Errorf("handle() the next Step was triggered by the dashboard")
+			}
+		})
+	}
+}
+
+func TestScheduler_runStep(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       string
+		runnerName string
+		busy       bool
+		wantQueued bool
+		wantPhases []types.StepPhase
+	}{
+		{
+			name:       "TestScheduler_runStep_1",
+			mode:       GroupModeDefault,
+			runnerName: "r1",
+			busy:       false,
+			wantQueued: false,
+			wantPhases: []types.StepPhase{types.StepRunning, types.StepPending},
+		},
+		{
+			name:       "TestScheduler_runStep_2",
+			mode:       GroupModeDefault,
+			runnerName: "r1",
+			busy:       true,
+			wantQueued: true,
+			wantPhases: []types.StepPhase{types.StepSucceeded, types.StepSucceeded},
+		},
+		{
+			name:       "TestScheduler_runStep_3",
+			mode:       GroupModePool,
+			runnerName: "",
+			busy:       true,
+			wantQueued: true,
+			wantPhases: []types.StepPhase{types.StepSucceeded, types.StepSucceeded},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				items:     map[types.Namespace]*Groups{"ns1": newGroups(true)},
+				broadcast: make(chan *broadcast, 10),
+				watchdog:  newWatchdog(),
+				states:    newPendingStates(),
+				locks:     make(map[string]*resourceLock, 0),
+			}
+			g := newGroup(tt.mode, "", true, nil, nil)
+			s.items["ns1"].items["g1"] = g
+			ri := &types.RunnerInfo{
+				Name:      "r1",
+				Namespace: "ns1",
+				GroupName: "g1",
+				Steps: []types.Step{
+					{Name: "build", Phase: types.StepSucceeded},
+					{Name: "upload", Phase: types.StepSucceeded},
+				},
+			}
+			g.Runners[ri.Name] = ri
+			g.addRunner(ri)
+			if tt.busy {
+				g.markRunning(ri.Name, "deploy", 1)
+			}
+			req := &types.RunStepRequest{Namespace: "ns1", GroupName: "g1", RunnerName: tt.runnerName, Step: types.Step{Name: "build"}}
+			res, err := s.runStep(req, origin{}, true, false)
+			if err != nil {
+				t.Fatalf("runStep() error = %v", err)
+			}
+			got := &types.RunStepResponse{}
+			if err = got.Unmarshal(res); err != nil {
+				t.Fatal(err)
+			}
+			if got.Queued != tt.wantQueued {
+				t.Errorf("runStep() queued = %v, want %v", got.Queued, tt.wantQueued)
+			}
+			if tt.wantQueued && got.Item.Request.Step.Name != "build" {
+				t.Errorf("runStep() item = %v, want the queued request", got.Item)
+			}
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			for i, v := range ri.Steps {
+				if v.Phase != tt.wantPhases[i] {
+					t.Errorf("runStep() step %s phase = %v, want %v", v.Name, v.Phase, tt.wantPhases[i])
+				}
+			}
+			if !tt.busy && !g.pool[ri.Name].ownedBy("build", 1) {
+				t.Errorf("runStep() the Runner wasn't kept busy by the Step")
+			}
+		})
+	}
+}
+
+// TestScheduler_removeRunner shows what the Runner lost after it disconnected, the takeover of the leader loses the same
+func TestScheduler_removeRunner(t *testing.T) {
+	tests := []struct {
+		name   string
+		closed int32
+		// wantDropped was true if the queue, the gate and the locks of the r1 were dropped
+		wantDropped bool
+	}{
+		{
+			name:        "TestScheduler_removeRunner_1",
+			closed:      1,
+			wantDropped: true,
+		},
+		{
+			name:        "TestScheduler_removeRunner_2",
+			closed:      2,
+			wantDropped: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				items:     map[types.Namespace]*Groups{"ns1": newGroups(true)},
+				broadcast: make(chan *broadcast, 10),
+				locks:     make(map[string]*resourceLock, 0),
+			}
+			g := newGroup(GroupModeDefault, "", true, nil, nil)
+			s.items["ns1"].items["g1"] = g
+			for id, name := range map[int32]string{1: "r1", 2: "r2"} {
+				ri := &types.RunnerInfo{Name: name, Namespace: "ns1", GroupName: "g1", Steps: []types.Step{{Name: "build"}, {Name: "upload"}}}
+				g.Runners[name] = ri
+				g.Ids[id] = name
+				g.sessions[name] = []int32{id}
+				g.addRunner(ri)
+			}
+			g.enqueueStep(&types.RunStepRequest{Namespace: "ns1", GroupName: "g1", RunnerName: "r1", Step: types.Step{Name: "build"}})
+			g.gates["r1/upload"] = &gate{req: &types.RunStepRequest{RunnerName: "r1", Step: types.Step{Name: "upload"}}}
+			s.acquireLocks(&types.RunStepRequest{Namespace: "ns1", GroupName: "g1", RunnerName: "r1", Step: types.Step{Name: "build"}}, []string{"svn"})
+			s.removeRunner(tt.closed)
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			_, queued := g.runQueues["r1"]
+			_, gated := g.gates["r1/upload"]
+			_, locked := s.locks["svn"]
+			if dropped := !queued && !gated && !locked; dropped != tt.wantDropped {
+				t.Errorf("removeRunner() queued = %v gated = %v locked = %v, want dropped %v", queued, gated, locked, tt.wantDropped)
+			}
+		})
+	}
+}
+
+func TestScheduler_acceptLogSeq(t *testing.T) {
+	tests := []struct {
+		name      string
+		duplicate string
+		seqs      []int64
+		want      []bool
+	}{
+		{
+			name:      "TestScheduler_acceptLogSeq_1",
+			duplicate: DuplicatePolicyReject,
+			seqs:      []int64{1, 2, 3, 2, 0, 1},
+			want:      []bool{true, true, true, false, true, true},
+		},
+		{
+			// the sessions of the pool spooled their own Seq
+			name:      "TestScheduler_acceptLogSeq_2",
+			duplicate: DuplicatePolicyPool,
+			seqs:      []int64{1, 2, 3, 2, 3},
+			want:      []bool{true, true, true, true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				items:   map[types.Namespace]*Groups{"ns1": newGroups(true)},
+				logSeqs: make(map[string]int64, 0),
+			}
+			s.items["ns1"].items["g1"] = newGroup(GroupModeDefault, tt.duplicate, true, nil, nil)
+			for i, seq := range tt.seqs {
+				req := &types.LogStreamRequest{Namespace: "ns1", GroupName: "g1", RunnerName: "r1", Seq: seq}
+				if got := s.acceptLogSeq(req); got != tt.want[i] {
+					t.Errorf("acceptLogSeq() seq:%d = %v, want %v", seq, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_recordsFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *types.ListRecordsRequest
+		wantWhere string
+		wantArgs  []interface{}
+		wantErr   bool
+	}{
+		{
+			name:      "Test_recordsFilter_1",
+			req:       &types.ListRecordsRequest{Namespace: "ns1", GroupName: "g1", IsVersion: types.RecordDefault},
+			wantWhere: "`namespace` = ? AND `groupName` = ?",
+			wantArgs:  []interface{}{types.Namespace("ns1"), types.GroupName("g1")},
+		},
+		{
+			name:      "Test_recordsFilter_2",
+			req:       &types.ListRecordsRequest{Namespace: "ns1", GroupName: "g1", RunnerName: "r1", IsVersion: types.RecordVersion},
+			wantWhere: "`namespace` = ? AND `groupName` = ? AND `runnerName` = ? AND `stepType` = ?",
+			wantArgs:  []interface{}{types.Namespace("ns1"), types.GroupName("g1"), "r1", int32(types.RecordVersion)},
+		},
+		{
+			name:    "Test_recordsFilter_3",
+			req:     &types.ListRecordsRequest{Namespace: "ns1", GroupName: "g1", IsVersion: 2},
+			wantErr: true,
+		},
+		{
+			name:    "Test_recordsFilter_4",
+			req:     &types.ListRecordsRequest{Namespace: "ns1", GroupName: "g1", IsVersion: -1},
+			wantErr: true,
+		},
+		{
+			name:    "Test_recordsFilter_5",
+			req:     &types.ListRecordsRequest{Namespace: "ns1", GroupName: "g1", Page: -1, Length: 20},
+			wantErr: true,
+		},
+		{
+			name:    "Test_recordsFilter_6",
+			req:     &types.ListRecordsRequest{Namespace: "ns1", GroupName: "g1", Length: -1},
+			wantErr: true,
+		},
+		{
+			name:    "Test_recordsFilter_7",
+			req:     &types.ListRecordsRequest{Namespace: "ns1", GroupName: "g1", Length: types.RecordsMaxLength + 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotWhere, gotArgs, err := recordsFilter(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("recordsFilter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if apiStatus(err) != http.StatusBadRequest {
+					t.Errorf("recordsFilter() error status = %v, want %v", apiStatus(err), http.StatusBadRequest)
+				}
+				return
+			}
+			if gotWhere != tt.wantWhere || !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("recordsFilter() = %v %v, want %v %v", gotWhere, gotArgs, tt.wantWhere, tt.wantArgs)
+			}
+		})
+	}
+}
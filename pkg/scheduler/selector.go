@@ -0,0 +1,125 @@
+package scheduler
+
+import (
+	"strings"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+const (
+	ErrSelectorWasInvalid     = "error: selector:%s was invalid"
+	ErrNoIdleRunnerWasMatched = "error: namespace:%s groupName:%s no idle runner matched selector:%s step:%s"
+)
+
+type operator string
+
+const (
+	operatorEquals       operator = "="
+	operatorNotEquals    operator = "!="
+	operatorExists       operator = "exists"
+	operatorDoesNotExist operator = "!"
+)
+
+// requirement was a single condition of the Selector
+type requirement struct {
+	key      string
+	operator operator
+	value    string
+}
+
+func (r requirement) matches(labels map[string]string) bool {
+	v, ok := labels[r.key]
+	switch r.operator {
+	case operatorEquals:
+		return ok && v == r.value
+	case operatorNotEquals:
+		return !ok || v != r.value
+	case operatorExists:
+		return ok
+	case operatorDoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+// Selector was a set of requirements which were ANDed, an empty Selector matches all the Runners
+type Selector []requirement
+
+// ParseSelector parses the comma separated requirements,
+// each of them was one of `key=value`, `key==value`, `key!=value`, `key` and `!key`
+func ParseSelector(in string) (Selector, error) {
+	res := make(Selector, 0)
+	for _, v := range strings.Split(in, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		var r requirement
+		switch {
+		case strings.Contains(v, "!="):
+			t := strings.SplitN(v, "!=", 2)
+			r = requirement{key: t[0], operator: operatorNotEquals, value: t[1]}
+		case strings.Contains(v, "=="):
+			t := strings.SplitN(v, "==", 2)
+			r = requirement{key: t[0], operator: operatorEquals, value: t[1]}
+		case strings.Contains(v, "="):
+			t := strings.SplitN(v, "=", 2)
+			r = requirement{key: t[0], operator: operatorEquals, value: t[1]}
+		case strings.HasPrefix(v, "!"):
+			r = requirement{key: v[1:], operator: operatorDoesNotExist}
+		default:
+			r = requirement{key: v, operator: operatorExists}
+		}
+		r.key, r.value = strings.TrimSpace(r.key), strings.TrimSpace(r.value)
+		if r.key == "" || strings.ContainsAny(r.key, "!=") || strings.ContainsAny(r.value, "!=") {
+			return nil, newError(types.CodeInvalid, ErrSelectorWasInvalid, in)
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// Matches reports whether all the requirements were satisfied by the labels
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Selector) String() string {
+	res := make([]string, 0, len(s))
+	for _, r := range s {
+		switch r.operator {
+		case operatorExists:
+			res = append(res, r.key)
+		case operatorDoesNotExist:
+			res = append(res, "!"+r.key)
+		default:
+			res = append(res, r.key+string(r.operator)+r.value)
+		}
+	}
+	return strings.Join(res, ",")
+}
+
+// runningStep returns the Step which was running by the Runner, it returns nil if the Runner was idle
+func runningStep(ri *types.RunnerInfo) *types.Step {
+	for i, v := range ri.Steps {
+		if v.Phase == types.StepRunning {
+			return &ri.Steps[i]
+		}
+	}
+	return nil
+}
+
+// hasStep reports whether the Runner offers the Step which was available
+func hasStep(ri *types.RunnerInfo, stepName string) bool {
+	for _, v := range ri.Steps {
+		if v.Name == stepName && v.Available != types.StepAvailableDisable {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"testing"
+)
+
+func TestParseSelector(t *testing.T) {
+	labels := map[string]string{
+		"os":     "linux",
+		"region": "cn",
+		"disk":   "ssd",
+	}
+	tests := []struct {
+		name     string
+		selector string
+		want     string
+		matches  bool
+		wantErr  bool
+	}{
+		{
+			name:     "TestParseSelector_1",
+			selector: "",
+			want:     "",
+			matches:  true,
+			wantErr:  false,
+		},
+		{
+			name:     "TestParseSelector_2",
+			selector: "os=linux, region==cn",
+			want:     "os=linux,region=cn",
+			matches:  true,
+			wantErr:  false,
+		},
+		{
+			name:     "TestParseSelector_3",
+			selector: "os=linux,disk!=ssd",
+			want:     "os=linux,disk!=ssd",
+			matches:  false,
+			wantErr:  false,
+		},
+		{
+			name:     "TestParseSelector_4",
+			selector: "disk,!gpu,role!=packer",
+			want:     "disk,!gpu,role!=packer",
+			matches:  true,
+			wantErr:  false,
+		},
+		{
+			name:     "TestParseSelector_5",
+			selector: "gpu",
+			want:     "gpu",
+			matches:  false,
+			wantErr:  false,
+		},
+		{
+			name:     "TestParseSelector_6",
+			selector: "=linux",
+			wantErr:  true,
+		},
+		{
+			name:     "TestParseSelector_7",
+			selector: "os=linux=ubuntu",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSelector(tt.selector)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSelector() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseSelector() got = %v, want %v", got.String(), tt.want)
+			}
+			if got.Matches(labels) != tt.matches {
+				t.Errorf("Selector.Matches() = %v, want %v", got.Matches(labels), tt.matches)
+			}
+		})
+	}
+}
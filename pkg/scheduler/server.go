@@ -11,6 +11,7 @@ import (
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-contrib/sessions/cookie"
 	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
 	"net/http"
 )
 
@@ -20,6 +21,8 @@ type Server struct {
 	httpServer  *http.Server
 	ctx         context.Context
 	cancel      context.CancelFunc
+	// elector was nil if the HA was disabled
+	elector *elector
 }
 
 func NewServer(c *conf.Config, rbacPath string) *Server {
@@ -34,14 +37,26 @@ func NewServer(c *conf.Config, rbacPath string) *Server {
 		cancel:      cancel,
 	}
 	zaplogger.Sugar().Info(33333)
+	if c.HA.Id != "" {
+		e, err := newElector(dao.Get(), c.HA, s.connections.scheduler.takeOver, s.connections.stepDown)
+		if err != nil {
+			klog.Fatal(err)
+		}
+		s.elector = e
+		go e.run(ctx)
+	}
 	router := gin.New()
+	router.Use(s.proxyToLeader)
 	store := cookie.NewStore([]byte("secret"))
 	router.Use(sessions.Sessions("sessionStore", store))
 	router.Use(cors.Default())
+	router.GET(types.HttpHandlerLeader, s.leader)
 	router.GET(types.HttpHandlerLogin, s.login.LoginHandler)
 	router.GET(types.HttpHandlerLogout, s.login.LogoutHandler)
 	router.GET(types.WebsocketHandlerDashboard, s.dashboard)
 	router.GET(types.WebsocketHandlerRunner, s.runner)
+	router.POST(types.HttpHandlerHooks, s.hook)
+	s.registerAPI(router.Group(types.HttpHandlerAPIv1))
 	server := &http.Server{
 		Addr:    fmt.Sprintf("0.0.0.0:%d", c.PublisherService.ListenPort),
 		Handler: router,
@@ -61,7 +76,7 @@ func NewServer(c *conf.Config, rbacPath string) *Server {
 
 func (s *Server) dashboard(c *gin.Context) {
 	zaplogger.Sugar().Infow("dashboard print token", "token", c.Param("token"))
-	s.connections.handlerDashboard(c.Writer, c.Request)
+	s.connections.handlerDashboard(c.Writer, c.Request, c.Param("token"))
 }
 
 func (s *Server) runner(c *gin.Context) {
@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// pendingStates keeps the latest RunnerInfo of each Runner which was waiting to be persisted.
+// The newer state replaces the older one, so that the persistRunner never blocks even if the MySQL stalls
+type pendingStates struct {
+	mu    sync.Mutex
+	items map[string]*types.RunnerInfo
+	// order was the keys of the items in the order of their first puts
+	order []string
+	// ready would be signaled after the items were put
+	ready chan struct{}
+}
+
+func newPendingStates() *pendingStates {
+	return &pendingStates{
+		items: make(map[string]*types.RunnerInfo, 0),
+		order: make([]string, 0),
+		ready: make(chan struct{}, 1),
+	}
+}
+
+func (ps *pendingStates) put(ri *types.RunnerInfo) {
+	key := fmt.Sprintf("%s/%s/%s", ri.Namespace, ri.GroupName, ri.Name)
+	ps.mu.Lock()
+	if _, ok := ps.items[key]; !ok {
+		ps.order = append(ps.order, key)
+	}
+	ps.items[key] = ri
+	ps.mu.Unlock()
+	select {
+	case ps.ready <- struct{}{}:
+	default:
+	}
+}
+
+// take removes and returns all the pending states
+func (ps *pendingStates) take() []*types.RunnerInfo {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	res := make([]*types.RunnerInfo, 0, len(ps.order))
+	for _, key := range ps.order {
+		res = append(res, ps.items[key])
+	}
+	ps.items = make(map[string]*types.RunnerInfo, 0)
+	ps.order = make([]string, 0)
+	return res
+}
+
+// persistRunner saves the current state of the Runner and all its Steps by the persistLoop,
+// it could be called while holding the s.mu
+func (s *Scheduler) persistRunner(ri *types.RunnerInfo) {
+	s.states.put(ri.DeepCopy())
+}
+
+func (s *Scheduler) persistLoop() {
+	for range s.states.ready {
+		for _, ri := range s.states.take() {
+			data, err := ri.Marshal()
+			if err != nil {
+				klog.V(2).Info(err)
+				continue
+			}
+			if err = s.dao.SaveRunnerState(string(ri.Namespace), string(ri.GroupName), ri.Name, data); err != nil {
+				klog.V(2).Info(err)
+			}
+		}
+	}
+}
+
+// loadRunner merges the persisted state into the RunnerInfo which was registered by the Runner
+func (s *Scheduler) loadRunner(ri *types.RunnerInfo) {
+	data, err := s.dao.GetRunnerState(string(ri.Namespace), string(ri.GroupName), ri.Name)
+	if err != nil || data == nil {
+		return
+	}
+	persisted := &types.RunnerInfo{}
+	if err = persisted.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return
+	}
+	mergeRunnerState(ri, persisted)
+}
+
+// mergeRunnerState keeps the settings of the Steps which were edited by the dashboards, such as the Envs,
+// and restores the phases which the Runner didn't know after it restarted.
+// The Steps which were not registered by the Runner anymore would be dropped.
+func mergeRunnerState(ri *types.RunnerInfo, persisted *types.RunnerInfo) {
+	steps := make(map[string]*types.Step, len(persisted.Steps))
+	for i := range persisted.Steps {
+		steps[persisted.Steps[i].Name] = &persisted.Steps[i]
+	}
+	for i := range ri.Steps {
+		v := &ri.Steps[i]
+		p, ok := steps[v.Name]
+		if !ok {
+			continue
+		}
+		if p.Policy != "" {
+			v.Policy = p.Policy
+		}
+		if p.Available != "" {
+			v.Available = p.Available
+		}
+		v.Envs = mergeMap(v.Envs, p.Envs)
+		v.SharingData = mergeMap(v.SharingData, p.SharingData)
+		if v.Phase != types.StepPending && v.Phase != "" {
+			// the Runner knows what happened to the Step
+			continue
+		}
+		v.Phase = p.Phase
+		v.Messages = p.Messages
+		v.DurationInMS = p.DurationInMS
+		switch v.Phase {
+		case types.StepRunning:
+			// the Step was running before the Runner restarted, so the result was lost
+			v.Phase = types.StepUnknown
+		case types.StepAwaitingApproval, types.StepAwaitingLock:
+			// the gate or the lock waiter was dropped after the Runner disconnected, so the Step wouldn't be run
+			v.Phase = types.StepUnknown
+		}
+	}
+}
+
+// mergeMap returns the defaults overwritten by the persisted
+func mergeMap(defaults, persisted map[string]string) map[string]string {
+	if len(persisted) == 0 {
+		return defaults
+	}
+	res := make(map[string]string, len(defaults)+len(persisted))
+	for k, v := range defaults {
+		res[k] = v
+	}
+	for k, v := range persisted {
+		res[k] = v
+	}
+	return res
+}
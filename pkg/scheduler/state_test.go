@@ -0,0 +1,119 @@
+package scheduler
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func Test_mergeRunnerState(t *testing.T) {
+	tests := []struct {
+		name      string
+		ri        *types.RunnerInfo
+		persisted *types.RunnerInfo
+		want      []types.Step
+	}{
+		{
+			name: "Test_mergeRunnerState_1",
+			ri: &types.RunnerInfo{Steps: []types.Step{
+				{Name: "git", Phase: types.StepPending, Policy: types.StepPolicyManual, Envs: map[string]string{"A": "1", "B": "2"}},
+				{Name: "ftp", Phase: types.StepPending},
+			}},
+			persisted: &types.RunnerInfo{Steps: []types.Step{
+				{Name: "git", Phase: types.StepSucceeded, Policy: types.StepPolicyAuto, Envs: map[string]string{"B": "3"}, DurationInMS: 10},
+				{Name: "ftp", Phase: types.StepRunning},
+				{Name: "removed", Phase: types.StepFailed},
+			}},
+			want: []types.Step{
+				{Name: "git", Phase: types.StepSucceeded, Policy: types.StepPolicyAuto, Envs: map[string]string{"A": "1", "B": "3"}, DurationInMS: 10},
+				{Name: "ftp", Phase: types.StepUnknown},
+			},
+		},
+		{
+			name: "Test_mergeRunnerState_2",
+			ri: &types.RunnerInfo{Steps: []types.Step{
+				{Name: "git", Phase: types.StepRunning, SharingData: map[string]string{"version": "2"}},
+				{Name: "svn", Phase: types.StepPending},
+			}},
+			persisted: &types.RunnerInfo{Steps: []types.Step{
+				{Name: "git", Phase: types.StepFailed, SharingData: map[string]string{"version": "1", "tag": "t1"}},
+			}},
+			want: []types.Step{
+				{Name: "git", Phase: types.StepRunning, SharingData: map[string]string{"version": "1", "tag": "t1"}},
+				{Name: "svn", Phase: types.StepPending},
+			},
+		},
+		{
+			name: "Test_mergeRunnerState_3",
+			ri: &types.RunnerInfo{Steps: []types.Step{
+				{Name: "git", Phase: types.StepPending},
+				{Name: "ftp", Phase: types.StepPending},
+			}},
+			persisted: &types.RunnerInfo{Steps: []types.Step{
+				{Name: "git", Phase: types.StepAwaitingApproval},
+				{Name: "ftp", Phase: types.StepAwaitingLock},
+			}},
+			want: []types.Step{
+				{Name: "git", Phase: types.StepUnknown},
+				{Name: "ftp", Phase: types.StepUnknown},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mergeRunnerState(tt.ri, tt.persisted)
+			if !reflect.DeepEqual(tt.ri.Steps, tt.want) {
+				t.Errorf("mergeRunnerState() = %v, want %v", tt.ri.Steps, tt.want)
+			}
+		})
+	}
+}
+
+func Test_pendingStates(t *testing.T) {
+	tests := []struct {
+		name string
+		puts []*types.RunnerInfo
+		want []string
+	}{
+		{
+			name: "Test_pendingStates_1",
+			puts: []*types.RunnerInfo{
+				{Name: "r1", Hostname: "a"},
+				{Name: "r2", Hostname: "b"},
+				{Name: "r1", Hostname: "c"},
+			},
+			want: []string{"r1/c", "r2/b"},
+		},
+		{
+			name: "Test_pendingStates_2",
+			puts: func() []*types.RunnerInfo {
+				// the puts wouldn't block without the persistLoop
+				res := make([]*types.RunnerInfo, 0)
+				for i := 0; i < 4096; i++ {
+					res = append(res, &types.RunnerInfo{Name: "r1", Hostname: "a"})
+				}
+				return res
+			}(),
+			want: []string{"r1/a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := newPendingStates()
+			for _, v := range tt.puts {
+				ps.put(v)
+			}
+			got := make([]string, 0)
+			for _, v := range ps.take() {
+				got = append(got, v.Name+"/"+v.Hostname)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("take() = %v, want %v", got, tt.want)
+			}
+			if len(ps.take()) != 0 {
+				t.Errorf("take() the states were not removed")
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package scheduler
+
+import (
+	"sync"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ErrSubscriptionWasNotExisted = "error: subscription namespace:%s groupName:%s runner:%s step:%s logs:%v was not existed"
+)
+
+// subscriptions hold the Subscriptions of the dashboards by their connection ids
+type subscriptions struct {
+	mu    sync.RWMutex
+	items map[int32][]types.Subscription
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{
+		items: make(map[int32][]types.Subscription, 0),
+	}
+}
+
+// add appends the Subscription if the dashboard didn't subscribe it before, and returns all of its Subscriptions
+func (ss *subscriptions) add(id int32, sub types.Subscription) []types.Subscription {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	for _, v := range ss.items[id] {
+		if v == sub {
+			return append([]types.Subscription{}, ss.items[id]...)
+		}
+	}
+	ss.items[id] = append(ss.items[id], sub)
+	return append([]types.Subscription{}, ss.items[id]...)
+}
+
+// remove returns the remaining Subscriptions of the dashboard, the ok was false if the Subscription was not existed
+func (ss *subscriptions) remove(id int32, sub types.Subscription) (res []types.Subscription, ok bool) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	res = make([]types.Subscription, 0, len(ss.items[id]))
+	for _, v := range ss.items[id] {
+		if v == sub {
+			ok = true
+			continue
+		}
+		res = append(res, v)
+	}
+	if !ok {
+		return nil, false
+	}
+	// the dashboard which unsubscribed all of them would only receive the broadcasts without the scope
+	ss.items[id] = res
+	return append([]types.Subscription{}, res...), true
+}
+
+// drop removes all the Subscriptions of the closed connection
+func (ss *subscriptions) drop(id int32) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	delete(ss.items, id)
+}
+
+// matches reports whether the dashboard would receive the broadcast of the scope,
+// the nil scope and the dashboard which never subscribed anything always match
+func (ss *subscriptions) matches(id int32, scope *types.Subscription) bool {
+	if scope == nil {
+		return true
+	}
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	items, ok := ss.items[id]
+	if !ok {
+		return true
+	}
+	for _, v := range items {
+		if subscriptionMatches(v, *scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// subscriptionMatches reports whether the Subscription covers the scope of the broadcast.
+// The empty field of the scope means the broadcast was about all of them, such as the PipelineProgress of the group
+func subscriptionMatches(sub, scope types.Subscription) bool {
+	if scope.Logs && !sub.Logs {
+		return false
+	}
+	return matchField(string(sub.Namespace), string(scope.Namespace)) &&
+		matchField(string(sub.GroupName), string(scope.GroupName)) &&
+		matchField(sub.RunnerName, scope.RunnerName) &&
+		matchField(sub.StepName, scope.StepName)
+}
+
+func matchField(sub, scope string) bool {
+	return sub == "" || scope == "" || sub == scope
+}
+
+func (s *Scheduler) handleSubscribe(data []byte, clientId int32) (res []byte, err error) {
+	req := &types.SubscribeRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	result := &types.SubscribeResponse{
+		Items: s.subscriptions.add(clientId, req.Subscription),
+	}
+	return result.Marshal()
+}
+
+func (s *Scheduler) handleUnsubscribe(data []byte, clientId int32) (res []byte, err error) {
+	req := &types.UnsubscribeRequest{}
+	if err = req.Unmarshal(data); err != nil {
+		klog.V(2).Info(err)
+		return nil, newError(types.CodeInvalid, ErrRequestWasInvalid, err)
+	}
+	items, ok := s.subscriptions.remove(clientId, req.Subscription)
+	if !ok {
+		v := req.Subscription
+		return nil, newError(types.CodeNotFound, ErrSubscriptionWasNotExisted, v.Namespace, v.GroupName, v.RunnerName, v.StepName, v.Logs)
+	}
+	result := &types.UnsubscribeResponse{
+		Items: items,
+	}
+	return result.Marshal()
+}
@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestScheduler_subscriptionMatches(t *testing.T) {
+	step := types.Subscription{
+		Namespace:  "ns1",
+		GroupName:  "g1",
+		RunnerName: "r1",
+		StepName:   "upload",
+	}
+	logs := step
+	logs.Logs = true
+	tests := []struct {
+		name  string
+		sub   types.Subscription
+		scope types.Subscription
+		want  bool
+	}{
+		{
+			name:  "TestScheduler_subscriptionMatches_1",
+			sub:   types.Subscription{Namespace: "ns1"},
+			scope: step,
+			want:  true,
+		},
+		{
+			name:  "TestScheduler_subscriptionMatches_2",
+			sub:   types.Subscription{Namespace: "ns2"},
+			scope: step,
+			want:  false,
+		},
+		{
+			name:  "TestScheduler_subscriptionMatches_3",
+			sub:   types.Subscription{Namespace: "ns1", GroupName: "g1"},
+			scope: logs,
+			want:  false,
+		},
+		{
+			name:  "TestScheduler_subscriptionMatches_4",
+			sub:   types.Subscription{Namespace: "ns1", GroupName: "g1", Logs: true},
+			scope: logs,
+			want:  true,
+		},
+		{
+			name:  "TestScheduler_subscriptionMatches_5",
+			sub:   types.Subscription{Namespace: "ns1", GroupName: "g1", RunnerName: "r1", StepName: "build"},
+			scope: step,
+			want:  false,
+		},
+		{
+			name:  "TestScheduler_subscriptionMatches_6",
+			sub:   types.Subscription{Namespace: "ns1", GroupName: "g1", RunnerName: "r2"},
+			scope: types.Subscription{Namespace: "ns1", GroupName: "g1"},
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := subscriptionMatches(tt.sub, tt.scope); got != tt.want {
+				t.Errorf("subscriptionMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
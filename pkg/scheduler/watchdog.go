@@ -0,0 +1,107 @@
+package scheduler
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// StepTimeoutGracePeriod was the extra duration the Scheduler would wait for the UpdateStep from the Runner
+	// after the timeout of the Step, because the Runner needs time to kill the commands and report.
+	StepTimeoutGracePeriod = time.Second * 30
+)
+
+// watchdog holds a timer for each running Step which has a timeout
+type watchdog struct {
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+}
+
+func newWatchdog() *watchdog {
+	return &watchdog{
+		timers: make(map[string]*time.Timer, 0),
+	}
+}
+
+func stepKey(namespace types.Namespace, groupName types.GroupName, runnerName, stepName string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", namespace, groupName, runnerName, stepName)
+}
+
+// watch calls the f after the duration unless the stop was called with the same key before
+func (w *watchdog) watch(key string, d time.Duration, f func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if t, ok := w.timers[key]; ok {
+		t.Stop()
+	}
+	var t *time.Timer
+	t = time.AfterFunc(d, func() {
+		w.mu.Lock()
+		if w.timers[key] != t {
+			w.mu.Unlock()
+			return
+		}
+		delete(w.timers, key)
+		w.mu.Unlock()
+		f()
+	})
+	w.timers[key] = t
+}
+
+// stop reports whether the f of the key was waiting to be called
+func (w *watchdog) stop(key string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	t, ok := w.timers[key]
+	if ok {
+		t.Stop()
+		delete(w.timers, key)
+	}
+	return ok
+}
+
+// expireStep marks the Step as StepUnknown when the Runner hasn't reported it before the deadline
+func (s *Scheduler) expireStep(namespace types.Namespace, groupName types.GroupName, runnerName, stepName string) {
+	g, err := s.getGroup(namespace, groupName)
+	if err != nil {
+		klog.V(2).Info(err)
+		return
+	}
+	s.mu.Lock()
+	ri, ok := g.Runners[runnerName]
+	if !ok {
+		s.mu.Unlock()
+		klog.V(2).Infof(ErrRunnerWasNotExisted, namespace, groupName, runnerName)
+		return
+	}
+	var expired *types.Step
+	for i, v := range ri.Steps {
+		if v.Name != stepName || v.Phase != types.StepRunning {
+			continue
+		}
+		klog.Info("expireStep name:", stepName)
+		v.Phase = types.StepUnknown
+		v.Reason = types.StepReasonTimeout
+		v.Messages = append(v.Messages, types.StepTerminatedMessage(stepName, types.StepReasonTimeout))
+		ri.Steps[i] = v
+		expired = v.DeepCopy()
+	}
+	if expired != nil {
+		s.persistRunner(ri)
+	}
+	s.mu.Unlock()
+	if expired == nil {
+		return
+	}
+	if err = s.updateStepToDashboard(namespace, groupName, runnerName, expired, origin{}); err != nil {
+		klog.V(2).Info(err)
+	}
+	go s.releaseRunner(namespace, groupName, runnerName, stepName, expired.Attempt)
+	go s.releaseLocks(namespace, groupName, runnerName, stepName)
+	go s.completeSchedules(namespace, groupName, runnerName, stepName, types.StepUnknown)
+	go s.advancePipeline(g, runnerName, stepName, types.StepUnknown)
+}
@@ -0,0 +1,127 @@
+package scheduler
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func TestWatchdog_watch(t *testing.T) {
+	tests := []struct {
+		name string
+		// rewatch watches the same key again before the first one was called
+		rewatch  bool
+		stop     bool
+		wantStop bool
+		want     int32
+	}{
+		{
+			name: "TestWatchdog_watch_1",
+			want: 1,
+		},
+		{
+			name:     "TestWatchdog_watch_2",
+			stop:     true,
+			wantStop: true,
+			want:     0,
+		},
+		{
+			name:    "TestWatchdog_watch_3",
+			rewatch: true,
+			want:    2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newWatchdog()
+			var got int32
+			w.watch("k", time.Millisecond*20, func() {
+				atomic.AddInt32(&got, 1)
+			})
+			if tt.rewatch {
+				w.watch("k", time.Millisecond*20, func() {
+					atomic.AddInt32(&got, 2)
+				})
+			}
+			if tt.stop {
+				if stopped := w.stop("k"); stopped != tt.wantStop {
+					t.Errorf("watchdog.stop() = %v, want %v", stopped, tt.wantStop)
+				}
+			}
+			time.Sleep(time.Millisecond * 100)
+			if v := atomic.LoadInt32(&got); v != tt.want {
+				t.Errorf("watchdog.watch() called = %v, want %v", v, tt.want)
+			}
+			// the f which had been called couldn't be stopped
+			if w.stop("k") {
+				t.Errorf("watchdog.stop() = true after the f was called or stopped")
+			}
+		})
+	}
+}
+
+func TestScheduler_expireStep(t *testing.T) {
+	tests := []struct {
+		name       string
+		runnerName string
+		phase      types.StepPhase
+		wantPhase  types.StepPhase
+	}{
+		{
+			name:       "TestScheduler_expireStep_1",
+			runnerName: "r1",
+			phase:      types.StepRunning,
+			wantPhase:  types.StepUnknown,
+		},
+		{
+			name:       "TestScheduler_expireStep_2",
+			runnerName: "r1",
+			phase:      types.StepSucceeded,
+			wantPhase:  types.StepSucceeded,
+		},
+		{
+			name:       "TestScheduler_expireStep_3",
+			runnerName: "r2",
+			phase:      types.StepRunning,
+			wantPhase:  types.StepRunning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Scheduler{
+				items:     map[types.Namespace]*Groups{"ns1": newGroups(true)},
+				broadcast: make(chan *broadcast, 10),
+				states:    newPendingStates(),
+				locks:     make(map[string]*resourceLock, 0),
+				schedules: newSchedules(),
+			}
+			g := newGroup(GroupModeDefault, "", true, nil, nil)
+			s.items["ns1"].items["g1"] = g
+			ri := &types.RunnerInfo{
+				Name:      "r1",
+				Namespace: "ns1",
+				GroupName: "g1",
+				Steps:     []types.Step{{Name: "build", Phase: tt.phase, Attempt: 1}},
+			}
+			g.Runners[ri.Name] = ri
+			g.addRunner(ri)
+			s.expireStep("ns1", "g1", tt.runnerName, "build")
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			got := ri.Steps[0]
+			if got.Phase != tt.wantPhase {
+				t.Errorf("expireStep() phase = %v, want %v", got.Phase, tt.wantPhase)
+			}
+			expired := tt.wantPhase == types.StepUnknown
+			if expired != (len(got.Messages) == 1 && strings.Contains(got.Messages[0], types.StepReasonTimeout)) {
+				t.Errorf("expireStep() messages = %v, want the timeout message %v", got.Messages, expired)
+			}
+			if expired != (len(s.broadcast) == 1) {
+				t.Errorf("expireStep() broadcasts = %v, want the expired Step %v", len(s.broadcast), expired)
+			}
+		})
+	}
+}
@@ -9,11 +9,25 @@ const (
 	// ws
 	WebsocketHandlerRunner    = "/runner"
 	WebsocketHandlerDashboard = "/dashboard/:token"
+	// WebsocketConnectionTimeout was the seconds which the Scheduler kept the connection without the Ping,
+	// so the Runners and the dashboards ping it in every half of the timeout
+	WebsocketConnectionTimeout = 10
 
 	HttpHandlerLogin  = "/login"
 	HttpHandlerLogout = "/logout"
+	HttpHandlerHooks  = "/hooks/:namespace/:group/:name"
+	// HttpHandlerAPIv1 was the prefix of the versioned JSON REST API
+	HttpHandlerAPIv1 = "/api/v1"
+	// HttpHandlerLeader shows the current leader of the Schedulers, it wouldn't be proxied by the followers
+	HttpHandlerLeader = "/leader"
+
+	// the encodings of the websocket frames, which were negotiated by the subprotocol or the query `encoding`
+	EncodingProtobuf = "protobuf"
+	EncodingJSON     = "json"
 
 	PublisherProjectDir = "PUBLISHER_PROJECT_DIR"
+	// PublisherStepTimeout was the timeout in seconds of running a Step
+	PublisherStepTimeout = "PUBLISHER_STEP_TIMEOUT"
 	// git config
 	PublisherGitBranch     = "PUBLISHER_GIT_BRANCH"
 	PublisherGitSource     = "PUBLISHER_GIT_SOURCE"
@@ -50,10 +64,28 @@ const (
 	RecordVersion
 )
 
+// RecordsMaxLength was the max length of the records in one page
+const RecordsMaxLength = 100
+
 const (
-	StepMessageFormat = "[%s] StepName: [%s] Message: [%s] is starting"
+	StepMessageFormat           = "[%s] StepName: [%s] Message: [%s] is starting"
+	StepTerminatedMessageFormat = "[%s] StepName: [%s] Message: [%s]"
+)
+
+const (
+	// StepReasonCancelled was the reason of the Step which was cancelled by the CancelStep
+	StepReasonCancelled = "cancelled"
+	// StepReasonTimeout was the reason of the Step which has been running longer than its timeout
+	StepReasonTimeout = "timeout"
+	// StepReasonError was the reason of the Step which failed by itself, such as the non-zero exit code
+	StepReasonError = "error"
 )
 
 func StepMessage(stepName, action string) string {
 	return fmt.Sprintf(StepMessageFormat, time.Now().Format("2006-01-02 15:04:05"), stepName, action)
 }
+
+// StepTerminatedMessage returns the message of a Step which has been terminated with the reason
+func StepTerminatedMessage(stepName, reason string) string {
+	return fmt.Sprintf(StepTerminatedMessageFormat, time.Now().Format("2006-01-02 15:04:05"), stepName, reason)
+}
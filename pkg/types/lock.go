@@ -0,0 +1,28 @@
+package types
+
+// The Steps which declared the same lock couldn't run at the same time, even if they were in the different groups.
+// The lock would be held from the running until the Step was terminated, including the backoffs of its retries.
+
+// LockClaim was the Step which held the lock or was waiting for it
+type LockClaim struct {
+	Namespace  Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName  GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	RunnerName string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
+	StepName   string    `json:"stepName" protobuf:"bytes,4,opt,name=stepName"`
+	// ClaimedTM was the unix timestamp in milliseconds when the lock was acquired or the waiting started
+	ClaimedTM int64 `json:"claimedTM" protobuf:"varint,5,opt,name=claimedTM"`
+}
+
+// ResourceLock was the held lock, the Waiters would try to acquire it in the FIFO order after it was released
+type ResourceLock struct {
+	Name    string      `json:"name" protobuf:"bytes,1,opt,name=name"`
+	Holder  LockClaim   `json:"holder" protobuf:"bytes,2,opt,name=holder"`
+	Waiters []LockClaim `json:"waiters" protobuf:"bytes,3,opt,name=waiters"`
+}
+
+type ListLocksRequest struct {
+}
+
+type ListLocksResponse struct {
+	Items []ResourceLock `json:"items" protobuf:"bytes,1,opt,name=items"`
+}
@@ -0,0 +1,42 @@
+package types
+
+// PipelineNode was a (Runner, Step) pair of the Pipeline,
+// it would be started after all the nodes which it depends on succeeded
+type PipelineNode struct {
+	Name       string    `json:"name" protobuf:"bytes,1,opt,name=name"`
+	RunnerName string    `json:"runnerName" protobuf:"bytes,2,opt,name=runnerName"`
+	StepName   string    `json:"stepName" protobuf:"bytes,3,opt,name=stepName"`
+	DependsOn  []string  `json:"dependsOn" protobuf:"bytes,4,opt,name=dependsOn"`
+	Phase      StepPhase `json:"phase" protobuf:"bytes,5,opt,name=phase"`
+}
+
+// +Protocol
+// Pipeline was the progress of the pipeline which was declared in a Group,
+// it would be sent from the Scheduler to each web dashboard every time when a node was changed
+type Pipeline struct {
+	Namespace Namespace      `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName GroupName      `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	Phase     StepPhase      `json:"phase" protobuf:"bytes,3,opt,name=phase"`
+	Nodes     []PipelineNode `json:"nodes" protobuf:"bytes,4,opt,name=nodes"`
+	StartedTM int64          `json:"startedTM" protobuf:"varint,5,opt,name=startedTM"`
+	// Envs would overwrite the Envs of all the Steps of the current run
+	Envs map[string]string `json:"envs" protobuf:"bytes,6,opt,name=envs"`
+}
+
+type RunPipelineRequest struct {
+	Namespace Namespace         `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName GroupName         `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	Envs      map[string]string `json:"envs" protobuf:"bytes,3,opt,name=envs"`
+}
+
+type RunPipelineResponse struct {
+}
+
+type GetPipelineRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+}
+
+type GetPipelineResponse struct {
+	Pipeline Pipeline `json:"pipeline" protobuf:"bytes,1,opt,name=pipeline"`
+}
@@ -0,0 +1,64 @@
+package types
+
+// The namespaces and the groups could be managed at runtime besides the ones declared in the configuration,
+// the requests would be broadcast to all dashboards after they succeeded.
+
+type CreateNamespaceRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+}
+
+type CreateNamespaceResponse struct {
+}
+
+type RenameNamespaceRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	NewName   Namespace `json:"newName" protobuf:"bytes,2,opt,name=newName"`
+}
+
+type RenameNamespaceResponse struct {
+}
+
+type DeleteNamespaceRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+}
+
+type DeleteNamespaceResponse struct {
+}
+
+type CreateGroupRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	// Mode was empty or `pool`
+	Mode string `json:"mode" protobuf:"bytes,3,opt,name=mode"`
+	// Duplicate was the policy of the Runners which registered with the same name, it was empty, `replace` or `pool`
+	Duplicate string `json:"duplicate" protobuf:"bytes,4,opt,name=duplicate"`
+}
+
+type CreateGroupResponse struct {
+}
+
+type RenameGroupRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	NewName   GroupName `json:"newName" protobuf:"bytes,3,opt,name=newName"`
+}
+
+type RenameGroupResponse struct {
+}
+
+type DeleteGroupRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+}
+
+type DeleteGroupResponse struct {
+}
+
+type ListPendingRunnersRequest struct {
+}
+
+// ListPendingRunnersResponse contains the Runners which registered into the unknown groups,
+// they would be registered after the groups were created.
+type ListPendingRunnersResponse struct {
+	Runners []RunnerInfo `json:"runners" protobuf:"bytes,1,opt,name=runners"`
+}
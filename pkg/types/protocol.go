@@ -5,15 +5,43 @@ package types
 type Request struct {
 	Type Type   `json:"type" protobuf:"bytes,1,opt,name=type"`
 	Data []byte `json:"data" protobuf:"bytes,2,opt,name=data"`
+	// Id was generated by the sender to correlate the Responses with the Request
+	Id string `json:"id" protobuf:"bytes,3,opt,name=id"`
+	// Timestamp was the unix time in milliseconds when the Request was sent
+	Timestamp int64 `json:"timestamp" protobuf:"varint,4,opt,name=timestamp"`
 }
 
+// The Codes of the Response, they were the same as the http status codes
+const (
+	CodeOK int32 = 0
+	// CodeInvalid was the malformed Request or the invalid parameters
+	CodeInvalid int32 = 400
+	// CodeForbidden was the Request which the sender wasn't allowed to send
+	CodeForbidden int32 = 403
+	// CodeNotFound was the non-existent namespace, group, runner, step or other resources
+	CodeNotFound int32 = 404
+	// CodeBusy was the resource which was running or wasn't in the expected phase
+	CodeBusy int32 = 409
+	// CodeGone was the connection which was closed by the Scheduler on purpose, such as the Runner which was replaced
+	// by its duplicate. The Runner wouldn't redial the Scheduler after it received the Code
+	CodeGone     int32 = 410
+	CodeInternal int32 = 500
+)
+
 // +Protocol
 // Response was the context which would be sent from the Scheduler.
+// The Code was CodeOK if the Request was handled successfully, otherwise the Message was the reason.
 type Response struct {
 	Code    int32  `json:"code" protobuf:"varint,1,opt,name=code"`
 	Message string `json:"message" protobuf:"bytes,2,opt,name=message"`
 	Type    Type   `json:"type" protobuf:"bytes,3,opt,name=type"`
 	Data    []byte `json:"data" protobuf:"bytes,4,opt,name=data"`
+	// Id and Timestamp were echoed from the Request which the Response answered or resulted from
+	Id        string `json:"id" protobuf:"bytes,5,opt,name=id"`
+	Timestamp int64  `json:"timestamp" protobuf:"varint,6,opt,name=timestamp"`
+	// Broadcast was true if the Response wasn't the reply of the Request but was caused by it, such as the UpdateStep
+	// which was sent to all dashboards after the RunStep
+	Broadcast bool `json:"broadcast" protobuf:"varint,7,opt,name=broadcast"`
 }
 
 type Body string
@@ -62,6 +90,19 @@ type RunnerInfo struct {
 	GroupName  GroupName  `json:"groupName" protobuf:"bytes,4,opt,name=groupName"`
 	RunnerType RunnerType `json:"runnerType" protobuf:"bytes,5,opt,name=runnerType"`
 	Steps      []Step     `json:"steps" protobuf:"bytes,6,opt,name=steps"`
+	// Labels were the key/value pairs which describe the capabilities of the Runner, such as `os=linux`
+	Labels map[string]string `json:"labels" protobuf:"bytes,7,opt,name=labels"`
+}
+
+// DuplicateRunnerEvent would be broadcast to all dashboards when a Runner registered with the name of a registered one.
+// The Policy was the way how the Scheduler handled it, the Hostname was the newcomer's.
+type DuplicateRunnerEvent struct {
+	Namespace        Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName        GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	RunnerName       string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
+	Hostname         string    `json:"hostname" protobuf:"bytes,4,opt,name=hostname"`
+	PreviousHostname string    `json:"previousHostname" protobuf:"bytes,5,opt,name=previousHostname"`
+	Policy           string    `json:"policy" protobuf:"bytes,6,opt,name=policy"`
 }
 
 type ServiceAPI string
@@ -76,6 +117,30 @@ const (
 	RunStep                        ServiceAPI = "RunStep"
 	LogStream                      ServiceAPI = "LogStream"
 	CompleteStep                   ServiceAPI = "CompleteStep"
+	CancelStep                     ServiceAPI = "CancelStep"
+	RunPipeline                    ServiceAPI = "RunPipeline"
+	GetPipeline                    ServiceAPI = "GetPipeline"
+	PipelineProgress               ServiceAPI = "PipelineProgress"
+	ListSchedules                  ServiceAPI = "ListSchedules"
+	CreateSchedule                 ServiceAPI = "CreateSchedule"
+	PauseSchedule                  ServiceAPI = "PauseSchedule"
+	DeleteSchedule                 ServiceAPI = "DeleteSchedule"
+	CreateNamespace                ServiceAPI = "CreateNamespace"
+	RenameNamespace                ServiceAPI = "RenameNamespace"
+	DeleteNamespace                ServiceAPI = "DeleteNamespace"
+	CreateGroup                    ServiceAPI = "CreateGroup"
+	RenameGroup                    ServiceAPI = "RenameGroup"
+	DeleteGroup                    ServiceAPI = "DeleteGroup"
+	ListPendingRunners             ServiceAPI = "ListPendingRunners"
+	DuplicateRunner                ServiceAPI = "DuplicateRunner"
+	RunnerQueue                    ServiceAPI = "RunnerQueue"
+	ListRunnerQueue                ServiceAPI = "ListRunnerQueue"
+	CancelQueuedStep               ServiceAPI = "CancelQueuedStep"
+	MoveQueuedStep                 ServiceAPI = "MoveQueuedStep"
+	ApproveStep                    ServiceAPI = "ApproveStep"
+	ListLocks                      ServiceAPI = "ListLocks"
+	Subscribe                      ServiceAPI = "Subscribe"
+	Unsubscribe                    ServiceAPI = "Unsubscribe"
 	ServiceAPIListRecordsRequest   ServiceAPI = "ListRecordsRequest"
 	ServiceAPIListRecordsResponse  ServiceAPI = "ListRecordsResponse"
 	ServiceAPIListVersionsRequest  ServiceAPI = "ListVersionRequest"
@@ -128,9 +193,20 @@ type RunStepRequest struct {
 	GroupName  GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
 	RunnerName string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
 	Step       Step      `json:"step" protobuf:"bytes,4,opt,name=step"`
+	// Selector was the label selector such as `os=linux,role!=packer,disk,!gpu`.
+	// The Scheduler would pick an idle matching Runner in the group when the RunnerName was empty.
+	Selector string `json:"selector" protobuf:"bytes,5,opt,name=selector"`
 }
 
+// RunStepResponse contains the QueuedStep if the specified Runner was busy or no Runner of the pool group was idle,
+// the Position was in the queue of the group for the latter. It was empty if the Step was sent.
+// The AwaitingApproval was true if the Step was gated, it would be run or queued after it was approved.
 type RunStepResponse struct {
+	Queued           bool       `json:"queued" protobuf:"varint,1,opt,name=queued"`
+	Item             QueuedStep `json:"item" protobuf:"bytes,2,opt,name=item"`
+	AwaitingApproval bool       `json:"awaitingApproval" protobuf:"varint,3,opt,name=awaitingApproval"`
+	// AwaitingLock was the name of the lock which was held by the others, the Step would be run after it was released
+	AwaitingLock string `json:"awaitingLock" protobuf:"bytes,4,opt,name=awaitingLock"`
 }
 
 type UpdateStepRequest struct {
@@ -153,6 +229,33 @@ type CompleteStepRequest struct {
 type CompleteStepResponse struct {
 }
 
+// +Protocol
+// CancelStepRequest would be sent from the web dashboard to the Scheduler, and then be transmitted to the specific Runner.
+// The Runner would kill the running commands of the Step and report it as failed.
+type CancelStepRequest struct {
+	Namespace  Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName  GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	RunnerName string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
+	StepName   string    `json:"stepName" protobuf:"bytes,4,opt,name=stepName"`
+}
+
+type CancelStepResponse struct {
+}
+
+// ApproveStepRequest approves the Step which was StepAwaitingApproval as the approver of the token
+type ApproveStepRequest struct {
+	Namespace  Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName  GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	RunnerName string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
+	StepName   string    `json:"stepName" protobuf:"bytes,4,opt,name=stepName"`
+}
+
+// ApproveStepResponse contains the approvals so far, the Step would be run after the Required approvals
+type ApproveStepResponse struct {
+	Approvals []Approval `json:"approvals" protobuf:"bytes,1,opt,name=approvals"`
+	Required  int32      `json:"required" protobuf:"varint,2,opt,name=required"`
+}
+
 // +Protocol
 // LogStreamRequest was the string which was transferred from the abstract Runner when the Runner was running a step.
 // And it would also be sent from the Scheduler to each web dashboard for showing and watching
@@ -162,9 +265,15 @@ type LogStreamRequest struct {
 	RunnerName string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
 	StepName   string    `json:"stepName" protobuf:"bytes,4,opt,name=stepName"`
 	Output     string    `json:"output" protobuf:"bytes,5,opt,name=output"`
+	// Seq was the sequence number of the line which was assigned by the Runner's spool,
+	// the Scheduler would acknowledge it and drop the lines which had been received.
+	Seq int64 `json:"seq" protobuf:"varint,6,opt,name=seq"`
 }
 
+// LogStreamResponse was the acknowledgement from the Scheduler to the Runner,
+// all the lines whose Seq were not greater than it had been received
 type LogStreamResponse struct {
+	Seq int64 `json:"seq" protobuf:"varint,1,opt,name=seq"`
 }
 
 // ListRecordsRequest
@@ -187,14 +296,14 @@ type ListRecordsResponse struct {
 }
 
 type LoginRequest struct {
-	Account string `json:"account"`
-	Pwd     string `json:"pwd"`
+	Account string `json:"account" protobuf:"bytes,1,opt,name=account"`
+	Pwd     string `json:"pwd" protobuf:"bytes,2,opt,name=pwd"`
 }
 
 type LogoutRequest struct {
 }
 
 type HttpResponse struct {
-	Code    int32  `json:"code"`
-	Message string `json:"message"`
+	Code    int32  `json:"code" protobuf:"varint,1,opt,name=code"`
+	Message string `json:"message" protobuf:"bytes,2,opt,name=message"`
 }
@@ -0,0 +1,55 @@
+package types
+
+// The RunStepRequests which specified a busy Runner would be queued by the Scheduler in the FIFO order,
+// the first one would be run after the Runner reported a terminated phase of the running Step.
+
+// QueuedStep was a RunStepRequest which was waiting in the queue of its Runner
+type QueuedStep struct {
+	Id int64 `json:"id" protobuf:"varint,1,opt,name=id"`
+	// Position was the zero-based index in the queue, the zero would be run first
+	Position int32          `json:"position" protobuf:"varint,2,opt,name=position"`
+	Request  RunStepRequest `json:"request" protobuf:"bytes,3,opt,name=request"`
+	// QueuedTM was the unix timestamp in milliseconds when the request was queued
+	QueuedTM int64 `json:"queuedTM" protobuf:"varint,4,opt,name=queuedTM"`
+}
+
+// RunnerQueueEvent would be broadcast to all dashboards after the queue of the Runner was changed
+type RunnerQueueEvent struct {
+	Namespace  Namespace    `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName  GroupName    `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	RunnerName string       `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
+	Items      []QueuedStep `json:"items" protobuf:"bytes,4,opt,name=items"`
+}
+
+type ListRunnerQueueRequest struct {
+	Namespace  Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName  GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	RunnerName string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
+}
+
+type ListRunnerQueueResponse struct {
+	Items []QueuedStep `json:"items" protobuf:"bytes,1,opt,name=items"`
+}
+
+type CancelQueuedStepRequest struct {
+	Namespace  Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName  GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	RunnerName string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
+	Id         int64     `json:"id" protobuf:"varint,4,opt,name=id"`
+}
+
+type CancelQueuedStepResponse struct {
+}
+
+type MoveQueuedStepRequest struct {
+	Namespace  Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName  GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	RunnerName string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
+	Id         int64     `json:"id" protobuf:"varint,4,opt,name=id"`
+	// Position was the new zero-based index, the one which was out of range would move it to the end
+	Position int32 `json:"position" protobuf:"varint,5,opt,name=position"`
+}
+
+type MoveQueuedStepResponse struct {
+	Items []QueuedStep `json:"items" protobuf:"bytes,1,opt,name=items"`
+}
@@ -0,0 +1,61 @@
+package types
+
+// Schedule fires the Step or the pipeline of the Group at the times which matched the Cron expression.
+// The Step would be run on the Runner if the RunnerName was specified, or on an idle Runner which offers it.
+// The pipeline of the Group would be run if the StepName was empty.
+type Schedule struct {
+	Id        int64     `json:"id" protobuf:"varint,1,opt,name=id"`
+	Namespace Namespace `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	GroupName GroupName `json:"groupName" protobuf:"bytes,3,opt,name=groupName"`
+	Name      string    `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// Cron was the standard 5 fields cron expression, such as `30 2 * * 1-5`
+	Cron       string `json:"cron" protobuf:"bytes,5,opt,name=cron"`
+	RunnerName string `json:"runnerName" protobuf:"bytes,6,opt,name=runnerName"`
+	StepName   string `json:"stepName" protobuf:"bytes,7,opt,name=stepName"`
+	// Envs would overwrite the Envs of the Step when it was fired
+	Envs   map[string]string `json:"envs" protobuf:"bytes,8,opt,name=envs"`
+	Paused bool              `json:"paused" protobuf:"varint,9,opt,name=paused"`
+	// NextFireTM was the unix timestamp of the next firing, it was zero when the Schedule was paused
+	NextFireTM int64 `json:"nextFireTM" protobuf:"varint,10,opt,name=nextFireTM"`
+	LastFireTM int64 `json:"lastFireTM" protobuf:"varint,11,opt,name=lastFireTM"`
+	// LastResult was the phase of the last fired Step or pipeline, or the error message if it couldn't be fired
+	LastResult string `json:"lastResult" protobuf:"bytes,12,opt,name=lastResult"`
+}
+
+type ListSchedulesRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+}
+
+type ListSchedulesResponse struct {
+	Items []Schedule `json:"items" protobuf:"bytes,1,opt,name=items"`
+}
+
+type CreateScheduleRequest struct {
+	Schedule Schedule `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+}
+
+type CreateScheduleResponse struct {
+	Schedule Schedule `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+}
+
+type PauseScheduleRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	Id        int64     `json:"id" protobuf:"varint,3,opt,name=id"`
+	// Paused was false for resuming the Schedule
+	Paused bool `json:"paused" protobuf:"varint,4,opt,name=paused"`
+}
+
+type PauseScheduleResponse struct {
+	Schedule Schedule `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+}
+
+type DeleteScheduleRequest struct {
+	Namespace Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	Id        int64     `json:"id" protobuf:"varint,3,opt,name=id"`
+}
+
+type DeleteScheduleResponse struct {
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
 // StepPhase is a label for the condition of a Step at the current time.
 type StepPhase string
 
@@ -20,6 +26,10 @@ const (
 	// StepUnknown means that for some reason the state of the Step could not be obtained, typically due
 	// to an error in communicating with the host of the Step.
 	StepUnknown StepPhase = "Unknown"
+	// StepAwaitingApproval means the Step was gated, and it would be run after enough distinct approvers approved it.
+	StepAwaitingApproval StepPhase = "AwaitingApproval"
+	// StepAwaitingLock means one of the locks of the Step was held by the others, and it would be run after the lock was released.
+	StepAwaitingLock StepPhase = "AwaitingLock"
 )
 
 type StepPolicy string
@@ -29,6 +39,15 @@ const (
 	StepPolicyManual StepPolicy = "manual"
 )
 
+type StepLockPolicy string
+
+const (
+	// StepLockPolicyQueue keeps the Runner busy until the held locks were released, it was the default one
+	StepLockPolicyQueue StepLockPolicy = "queue"
+	// StepLockPolicyReject rejects the running if any of the locks was held by the others
+	StepLockPolicyReject StepLockPolicy = "reject"
+)
+
 type StepAvailable string
 
 const (
@@ -68,6 +87,95 @@ type Step struct {
 	SharingData map[string]string `json:"sharingData" protobuf:"bytes,14,opt,name=sharingData"`
 	// SharingSetting determine whether the Step needing collection different SharingData
 	SharingSetting bool `json:"sharingSetting" protobuf:"bytes,15,opt,name=sharingSetting"`
+	// Reason was the StepReason of the terminated Step, such as the StepReasonCancelled and the StepReasonTimeout.
+	// It would be reset when the Step was run again
+	Reason string `json:"reason" protobuf:"bytes,16,opt,name=reason"`
+	// TimeoutInSec was the maximum duration of running the Step, zero means no limit.
+	// It could be overridden by the Envs[PublisherStepTimeout]
+	TimeoutInSec int32 `json:"timeoutInSec" protobuf:"varint,17,opt,name=timeoutInSec"`
+	// Retry was the policy of running the failed Step again by the Scheduler
+	Retry RetryPolicy `json:"retry" protobuf:"bytes,18,opt,name=retry"`
+	// Attempt was the number of the current running which started from 1, it would be increased by each retry
+	Attempt int32 `json:"attempt" protobuf:"varint,19,opt,name=attempt"`
+	// Approvals were the approvers who approved the gated Step before it was run
+	Approvals []Approval `json:"approvals" protobuf:"bytes,20,opt,name=approvals"`
+	// Locks were the names of the resources which the Step would hold during the running, such as the ftp work dir.
+	// They were shared by all the namespaces and the groups
+	Locks []string `json:"locks" protobuf:"bytes,21,opt,name=locks"`
+	// LockPolicy was StepLockPolicyQueue or StepLockPolicyReject, it decides the running when the locks were held by the others
+	LockPolicy StepLockPolicy `json:"lockPolicy" protobuf:"bytes,22,opt,name=lockPolicy"`
+}
+
+// Approval was the approver who approved the gated Step, and the unix timestamp when it was approved
+type Approval struct {
+	User       string `json:"user" protobuf:"bytes,1,opt,name=user"`
+	ApprovedTM int64  `json:"approvedTM" protobuf:"varint,2,opt,name=approvedTM"`
+}
+
+// RetryPolicy determines whether the failed Step would be run again, and how long the Scheduler would wait before it
+type RetryPolicy struct {
+	// MaxAttempts was the maximum number of the runnings including the first one, zero or one means no retry
+	MaxAttempts int32 `json:"maxAttempts" protobuf:"varint,1,opt,name=maxAttempts"`
+	// BackoffInSec was the waiting duration before the first retry, it would be doubled before each next one
+	BackoffInSec int32 `json:"backoffInSec" protobuf:"varint,2,opt,name=backoffInSec"`
+	// MaxBackoffInSec was the limit of the doubled waiting duration, zero means no limit
+	MaxBackoffInSec int32 `json:"maxBackoffInSec" protobuf:"varint,3,opt,name=maxBackoffInSec"`
+	// RetryOn were the reasons of the failures which could be retried, such as `error` and `timeout`.
+	// Empty means all of them, the cancelled Step would never be retried
+	RetryOn []string `json:"retryOn" protobuf:"bytes,4,opt,name=retryOn"`
+}
+
+// Timeout returns the maximum duration of running the Step, the Envs[PublisherStepTimeout] takes precedence over
+// the TimeoutInSec. Zero means that the Step could run without limit.
+func (in *Step) Timeout() time.Duration {
+	if v, ok := in.Envs[PublisherStepTimeout]; ok && v != "" {
+		if sec, err := strconv.Atoi(v); err == nil {
+			return time.Second * time.Duration(sec)
+		}
+	}
+	return time.Second * time.Duration(in.TimeoutInSec)
+}
+
+// FailedReason returns the Reason of the failed Step, it was StepReasonError
+// unless the Step was terminated by the cancellation or the timeout
+func (in *Step) FailedReason() string {
+	if in.Reason == "" {
+		return StepReasonError
+	}
+	return in.Reason
+}
+
+// Retryable reports whether the Step which failed by the reason at the attempt could be run again
+func (in *RetryPolicy) Retryable(attempt int32, reason string) bool {
+	if attempt < 1 {
+		attempt = 1
+	}
+	if reason == StepReasonCancelled || attempt >= in.MaxAttempts {
+		return false
+	}
+	if len(in.RetryOn) == 0 {
+		return true
+	}
+	for _, v := range in.RetryOn {
+		if v == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the waiting duration before the retry after the attempt,
+// the doubling would be stopped before the duration overflowed if there was no MaxBackoffInSec
+func (in *RetryPolicy) Backoff(attempt int32) time.Duration {
+	d := time.Second * time.Duration(in.BackoffInSec)
+	max := time.Second * time.Duration(in.MaxBackoffInSec)
+	for i := int32(1); i < attempt && (max == 0 || d < max) && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+	if max > 0 && d > max {
+		d = max
+	}
+	return d
 }
 
 type UploadFile struct {
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStep_Timeout(t *testing.T) {
+	tests := []struct {
+		name         string
+		timeoutInSec int32
+		envs         map[string]string
+		want         time.Duration
+	}{
+		{
+			name: "TestStep_Timeout_1",
+			want: 0,
+		},
+		{
+			name:         "TestStep_Timeout_2",
+			timeoutInSec: 60,
+			want:         time.Second * 60,
+		},
+		{
+			name:         "TestStep_Timeout_3",
+			timeoutInSec: 60,
+			envs:         map[string]string{PublisherStepTimeout: "10"},
+			want:         time.Second * 10,
+		},
+		{
+			name:         "TestStep_Timeout_4",
+			timeoutInSec: 60,
+			envs:         map[string]string{PublisherStepTimeout: "0"},
+			want:         0,
+		},
+		{
+			name:         "TestStep_Timeout_5",
+			timeoutInSec: 60,
+			envs:         map[string]string{PublisherStepTimeout: "ten"},
+			want:         time.Second * 60,
+		},
+		{
+			name:         "TestStep_Timeout_6",
+			timeoutInSec: 60,
+			envs:         map[string]string{PublisherStepTimeout: ""},
+			want:         time.Second * 60,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Step{
+				TimeoutInSec: tt.timeoutInSec,
+				Envs:         tt.envs,
+			}
+			if got := s.Timeout(); got != tt.want {
+				t.Errorf("Step.Timeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
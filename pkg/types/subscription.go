@@ -0,0 +1,34 @@
+package types
+
+// The dashboard which didn't subscribe anything would receive all the broadcasts as before,
+// and the one which subscribed would only receive the broadcasts which matched any of its Subscriptions.
+// The broadcasts which weren't related to any group, such as the CreateNamespace, would be sent to all dashboards.
+
+// Subscription matches the broadcasts by the Namespace, the GroupName, the RunnerName and the StepName,
+// the empty one matches all of them. The LogStream would be sent only if the Logs was true.
+type Subscription struct {
+	Namespace  Namespace `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	GroupName  GroupName `json:"groupName" protobuf:"bytes,2,opt,name=groupName"`
+	RunnerName string    `json:"runnerName" protobuf:"bytes,3,opt,name=runnerName"`
+	StepName   string    `json:"stepName" protobuf:"bytes,4,opt,name=stepName"`
+	Logs       bool      `json:"logs" protobuf:"varint,5,opt,name=logs"`
+}
+
+type SubscribeRequest struct {
+	Subscription Subscription `json:"subscription" protobuf:"bytes,1,opt,name=subscription"`
+}
+
+// SubscribeResponse contains all the Subscriptions of the dashboard
+type SubscribeResponse struct {
+	Items []Subscription `json:"items" protobuf:"bytes,1,opt,name=items"`
+}
+
+// UnsubscribeRequest removes the Subscription which was the same as it
+type UnsubscribeRequest struct {
+	Subscription Subscription `json:"subscription" protobuf:"bytes,1,opt,name=subscription"`
+}
+
+// UnsubscribeResponse contains the remaining Subscriptions of the dashboard
+type UnsubscribeResponse struct {
+	Items []Subscription `json:"items" protobuf:"bytes,1,opt,name=items"`
+}
@@ -1,3 +1,4 @@
+//go:build !ignore_autogenerated
 // +build !ignore_autogenerated
 
 /*
@@ -21,436 +22,404 @@ limitations under the License.
 package types
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CompleteStepRequest) DeepCopyInto(out *CompleteStepRequest) {
+func (in *Approval) DeepCopyInto(out *Approval) {
 	*out = *in
-	in.Step.DeepCopyInto(&out.Step)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompleteStepRequest.
-func (in *CompleteStepRequest) DeepCopy() *CompleteStepRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
 	if in == nil {
 		return nil
 	}
-	out := new(CompleteStepRequest)
+	out := new(Approval)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CompleteStepResponse) DeepCopyInto(out *CompleteStepResponse) {
+func (in *ApproveStepRequest) DeepCopyInto(out *ApproveStepRequest) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompleteStepResponse.
-func (in *CompleteStepResponse) DeepCopy() *CompleteStepResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApproveStepRequest.
+func (in *ApproveStepRequest) DeepCopy() *ApproveStepRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(CompleteStepResponse)
+	out := new(ApproveStepRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Group) DeepCopyInto(out *Group) {
+func (in *ApproveStepResponse) DeepCopyInto(out *ApproveStepResponse) {
 	*out = *in
-	if in.Runners != nil {
-		in, out := &in.Runners, &out.Runners
-		*out = make([]RunnerInfo, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]Approval, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Group.
-func (in *Group) DeepCopy() *Group {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApproveStepResponse.
+func (in *ApproveStepResponse) DeepCopy() *ApproveStepResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(Group)
+	out := new(ApproveStepResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListGroupNameRequest) DeepCopyInto(out *ListGroupNameRequest) {
+func (in *CancelQueuedStepRequest) DeepCopyInto(out *CancelQueuedStepRequest) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListGroupNameRequest.
-func (in *ListGroupNameRequest) DeepCopy() *ListGroupNameRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CancelQueuedStepRequest.
+func (in *CancelQueuedStepRequest) DeepCopy() *CancelQueuedStepRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(ListGroupNameRequest)
+	out := new(CancelQueuedStepRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListGroupNameResponse) DeepCopyInto(out *ListGroupNameResponse) {
+func (in *CancelQueuedStepResponse) DeepCopyInto(out *CancelQueuedStepResponse) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListGroupNameResponse.
-func (in *ListGroupNameResponse) DeepCopy() *ListGroupNameResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CancelQueuedStepResponse.
+func (in *CancelQueuedStepResponse) DeepCopy() *CancelQueuedStepResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(ListGroupNameResponse)
+	out := new(CancelQueuedStepResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListNamespaceRequest) DeepCopyInto(out *ListNamespaceRequest) {
+func (in *CancelStepRequest) DeepCopyInto(out *CancelStepRequest) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListNamespaceRequest.
-func (in *ListNamespaceRequest) DeepCopy() *ListNamespaceRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CancelStepRequest.
+func (in *CancelStepRequest) DeepCopy() *CancelStepRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(ListNamespaceRequest)
+	out := new(CancelStepRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListNamespaceResponse) DeepCopyInto(out *ListNamespaceResponse) {
+func (in *CancelStepResponse) DeepCopyInto(out *CancelStepResponse) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListNamespaceResponse.
-func (in *ListNamespaceResponse) DeepCopy() *ListNamespaceResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CancelStepResponse.
+func (in *CancelStepResponse) DeepCopy() *CancelStepResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(ListNamespaceResponse)
+	out := new(CancelStepResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListRecordsRequest) DeepCopyInto(out *ListRecordsRequest) {
+func (in *CompleteStepRequest) DeepCopyInto(out *CompleteStepRequest) {
 	*out = *in
+	in.Step.DeepCopyInto(&out.Step)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRecordsRequest.
-func (in *ListRecordsRequest) DeepCopy() *ListRecordsRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompleteStepRequest.
+func (in *CompleteStepRequest) DeepCopy() *CompleteStepRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(ListRecordsRequest)
+	out := new(CompleteStepRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListRecordsResponse) DeepCopyInto(out *ListRecordsResponse) {
+func (in *CompleteStepResponse) DeepCopyInto(out *CompleteStepResponse) {
 	*out = *in
-	out.Params = in.Params
-	if in.Records != nil {
-		in, out := &in.Records, &out.Records
-		*out = make([]Record, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRecordsResponse.
-func (in *ListRecordsResponse) DeepCopy() *ListRecordsResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompleteStepResponse.
+func (in *CompleteStepResponse) DeepCopy() *CompleteStepResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(ListRecordsResponse)
+	out := new(CompleteStepResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListRunnerRequest) DeepCopyInto(out *ListRunnerRequest) {
+func (in *CreateGroupRequest) DeepCopyInto(out *CreateGroupRequest) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRunnerRequest.
-func (in *ListRunnerRequest) DeepCopy() *ListRunnerRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateGroupRequest.
+func (in *CreateGroupRequest) DeepCopy() *CreateGroupRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(ListRunnerRequest)
+	out := new(CreateGroupRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ListRunnerResponse) DeepCopyInto(out *ListRunnerResponse) {
+func (in *CreateGroupResponse) DeepCopyInto(out *CreateGroupResponse) {
 	*out = *in
-	if in.Runners != nil {
-		in, out := &in.Runners, &out.Runners
-		*out = make([]RunnerInfo, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRunnerResponse.
-func (in *ListRunnerResponse) DeepCopy() *ListRunnerResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateGroupResponse.
+func (in *CreateGroupResponse) DeepCopy() *CreateGroupResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(ListRunnerResponse)
+	out := new(CreateGroupResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogStreamRequest) DeepCopyInto(out *LogStreamRequest) {
+func (in *CreateNamespaceRequest) DeepCopyInto(out *CreateNamespaceRequest) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStreamRequest.
-func (in *LogStreamRequest) DeepCopy() *LogStreamRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateNamespaceRequest.
+func (in *CreateNamespaceRequest) DeepCopy() *CreateNamespaceRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(LogStreamRequest)
+	out := new(CreateNamespaceRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogStreamResponse) DeepCopyInto(out *LogStreamResponse) {
+func (in *CreateNamespaceResponse) DeepCopyInto(out *CreateNamespaceResponse) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStreamResponse.
-func (in *LogStreamResponse) DeepCopy() *LogStreamResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateNamespaceResponse.
+func (in *CreateNamespaceResponse) DeepCopy() *CreateNamespaceResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(LogStreamResponse)
+	out := new(CreateNamespaceResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PingRequest) DeepCopyInto(out *PingRequest) {
+func (in *CreateScheduleRequest) DeepCopyInto(out *CreateScheduleRequest) {
 	*out = *in
+	in.Schedule.DeepCopyInto(&out.Schedule)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PingRequest.
-func (in *PingRequest) DeepCopy() *PingRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateScheduleRequest.
+func (in *CreateScheduleRequest) DeepCopy() *CreateScheduleRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(PingRequest)
+	out := new(CreateScheduleRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PongResponse) DeepCopyInto(out *PongResponse) {
+func (in *CreateScheduleResponse) DeepCopyInto(out *CreateScheduleResponse) {
 	*out = *in
+	in.Schedule.DeepCopyInto(&out.Schedule)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PongResponse.
-func (in *PongResponse) DeepCopy() *PongResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CreateScheduleResponse.
+func (in *CreateScheduleResponse) DeepCopy() *CreateScheduleResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(PongResponse)
+	out := new(CreateScheduleResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Record) DeepCopyInto(out *Record) {
+func (in *DeleteGroupRequest) DeepCopyInto(out *DeleteGroupRequest) {
 	*out = *in
-	if in.StepInfo != nil {
-		in, out := &in.StepInfo, &out.StepInfo
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Record.
-func (in *Record) DeepCopy() *Record {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteGroupRequest.
+func (in *DeleteGroupRequest) DeepCopy() *DeleteGroupRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(Record)
+	out := new(DeleteGroupRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegisterRunnerRequest) DeepCopyInto(out *RegisterRunnerRequest) {
+func (in *DeleteGroupResponse) DeepCopyInto(out *DeleteGroupResponse) {
 	*out = *in
-	in.RunnerInfo.DeepCopyInto(&out.RunnerInfo)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegisterRunnerRequest.
-func (in *RegisterRunnerRequest) DeepCopy() *RegisterRunnerRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteGroupResponse.
+func (in *DeleteGroupResponse) DeepCopy() *DeleteGroupResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(RegisterRunnerRequest)
+	out := new(DeleteGroupResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegisterRunnerResponse) DeepCopyInto(out *RegisterRunnerResponse) {
+func (in *DeleteNamespaceRequest) DeepCopyInto(out *DeleteNamespaceRequest) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegisterRunnerResponse.
-func (in *RegisterRunnerResponse) DeepCopy() *RegisterRunnerResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteNamespaceRequest.
+func (in *DeleteNamespaceRequest) DeepCopy() *DeleteNamespaceRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(RegisterRunnerResponse)
+	out := new(DeleteNamespaceRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Request) DeepCopyInto(out *Request) {
+func (in *DeleteNamespaceResponse) DeepCopyInto(out *DeleteNamespaceResponse) {
 	*out = *in
-	out.Type = in.Type
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Request.
-func (in *Request) DeepCopy() *Request {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteNamespaceResponse.
+func (in *DeleteNamespaceResponse) DeepCopy() *DeleteNamespaceResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(Request)
+	out := new(DeleteNamespaceResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Response) DeepCopyInto(out *Response) {
+func (in *DeleteScheduleRequest) DeepCopyInto(out *DeleteScheduleRequest) {
 	*out = *in
-	out.Type = in.Type
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Response.
-func (in *Response) DeepCopy() *Response {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteScheduleRequest.
+func (in *DeleteScheduleRequest) DeepCopy() *DeleteScheduleRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(Response)
+	out := new(DeleteScheduleRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Result) DeepCopyInto(out *Result) {
+func (in *DeleteScheduleResponse) DeepCopyInto(out *DeleteScheduleResponse) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeleteScheduleResponse.
+func (in *DeleteScheduleResponse) DeepCopy() *DeleteScheduleResponse {
+	if in == nil {
+		return nil
 	}
+	out := new(DeleteScheduleResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DuplicateRunnerEvent) DeepCopyInto(out *DuplicateRunnerEvent) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Result.
-func (in *Result) DeepCopy() *Result {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DuplicateRunnerEvent.
+func (in *DuplicateRunnerEvent) DeepCopy() *DuplicateRunnerEvent {
 	if in == nil {
 		return nil
 	}
-	out := new(Result)
+	out := new(DuplicateRunnerEvent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunStepRequest) DeepCopyInto(out *RunStepRequest) {
+func (in *GetPipelineRequest) DeepCopyInto(out *GetPipelineRequest) {
 	*out = *in
-	in.Step.DeepCopyInto(&out.Step)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStepRequest.
-func (in *RunStepRequest) DeepCopy() *RunStepRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GetPipelineRequest.
+func (in *GetPipelineRequest) DeepCopy() *GetPipelineRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(RunStepRequest)
+	out := new(GetPipelineRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunStepResponse) DeepCopyInto(out *RunStepResponse) {
+func (in *GetPipelineResponse) DeepCopyInto(out *GetPipelineResponse) {
 	*out = *in
+	in.Pipeline.DeepCopyInto(&out.Pipeline)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStepResponse.
-func (in *RunStepResponse) DeepCopy() *RunStepResponse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GetPipelineResponse.
+func (in *GetPipelineResponse) DeepCopy() *GetPipelineResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(RunStepResponse)
+	out := new(GetPipelineResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunnerInfo) DeepCopyInto(out *RunnerInfo) {
+func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
-	if in.Steps != nil {
-		in, out := &in.Steps, &out.Steps
-		*out = make([]Step, len(*in))
+	if in.Runners != nil {
+		in, out := &in.Runners, &out.Runners
+		*out = make([]RunnerInfo, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -458,80 +427,1097 @@ func (in *RunnerInfo) DeepCopyInto(out *RunnerInfo) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerInfo.
-func (in *RunnerInfo) DeepCopy() *RunnerInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Group.
+func (in *Group) DeepCopy() *Group {
 	if in == nil {
 		return nil
 	}
-	out := new(RunnerInfo)
+	out := new(Group)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Step) DeepCopyInto(out *Step) {
+func (in *HttpResponse) DeepCopyInto(out *HttpResponse) {
 	*out = *in
-	if in.Envs != nil {
-		in, out := &in.Envs, &out.Envs
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Output != nil {
-		in, out := &in.Output, &out.Output
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.UploadFiles != nil {
-		in, out := &in.UploadFiles, &out.UploadFiles
-		*out = make([]UploadFile, len(*in))
-		copy(*out, *in)
-	}
-	if in.WriteFiles != nil {
-		in, out := &in.WriteFiles, &out.WriteFiles
-		*out = make([]WriteFile, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HttpResponse.
+func (in *HttpResponse) DeepCopy() *HttpResponse {
+	if in == nil {
+		return nil
 	}
-	if in.Messages != nil {
-		in, out := &in.Messages, &out.Messages
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(HttpResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListGroupNameRequest) DeepCopyInto(out *ListGroupNameRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListGroupNameRequest.
+func (in *ListGroupNameRequest) DeepCopy() *ListGroupNameRequest {
+	if in == nil {
+		return nil
 	}
-	if in.Remarks != nil {
-		in, out := &in.Remarks, &out.Remarks
+	out := new(ListGroupNameRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListGroupNameResponse) DeepCopyInto(out *ListGroupNameResponse) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.SharingData != nil {
-		in, out := &in.SharingData, &out.SharingData
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Step.
-func (in *Step) DeepCopy() *Step {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListGroupNameResponse.
+func (in *ListGroupNameResponse) DeepCopy() *ListGroupNameResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(Step)
+	out := new(ListGroupNameResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Type) DeepCopyInto(out *Type) {
+func (in *ListLocksRequest) DeepCopyInto(out *ListLocksRequest) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Type.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListLocksRequest.
+func (in *ListLocksRequest) DeepCopy() *ListLocksRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ListLocksRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListLocksResponse) DeepCopyInto(out *ListLocksResponse) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceLock, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListLocksResponse.
+func (in *ListLocksResponse) DeepCopy() *ListLocksResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ListLocksResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListNamespaceRequest) DeepCopyInto(out *ListNamespaceRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListNamespaceRequest.
+func (in *ListNamespaceRequest) DeepCopy() *ListNamespaceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ListNamespaceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListNamespaceResponse) DeepCopyInto(out *ListNamespaceResponse) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListNamespaceResponse.
+func (in *ListNamespaceResponse) DeepCopy() *ListNamespaceResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ListNamespaceResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListPendingRunnersRequest) DeepCopyInto(out *ListPendingRunnersRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListPendingRunnersRequest.
+func (in *ListPendingRunnersRequest) DeepCopy() *ListPendingRunnersRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ListPendingRunnersRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListPendingRunnersResponse) DeepCopyInto(out *ListPendingRunnersResponse) {
+	*out = *in
+	if in.Runners != nil {
+		in, out := &in.Runners, &out.Runners
+		*out = make([]RunnerInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListPendingRunnersResponse.
+func (in *ListPendingRunnersResponse) DeepCopy() *ListPendingRunnersResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ListPendingRunnersResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListRecordsRequest) DeepCopyInto(out *ListRecordsRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRecordsRequest.
+func (in *ListRecordsRequest) DeepCopy() *ListRecordsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ListRecordsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListRecordsResponse) DeepCopyInto(out *ListRecordsResponse) {
+	*out = *in
+	out.Params = in.Params
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]Record, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRecordsResponse.
+func (in *ListRecordsResponse) DeepCopy() *ListRecordsResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ListRecordsResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListRunnerQueueRequest) DeepCopyInto(out *ListRunnerQueueRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRunnerQueueRequest.
+func (in *ListRunnerQueueRequest) DeepCopy() *ListRunnerQueueRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ListRunnerQueueRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListRunnerQueueResponse) DeepCopyInto(out *ListRunnerQueueResponse) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QueuedStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRunnerQueueResponse.
+func (in *ListRunnerQueueResponse) DeepCopy() *ListRunnerQueueResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ListRunnerQueueResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListRunnerRequest) DeepCopyInto(out *ListRunnerRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRunnerRequest.
+func (in *ListRunnerRequest) DeepCopy() *ListRunnerRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ListRunnerRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListRunnerResponse) DeepCopyInto(out *ListRunnerResponse) {
+	*out = *in
+	if in.Runners != nil {
+		in, out := &in.Runners, &out.Runners
+		*out = make([]RunnerInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListRunnerResponse.
+func (in *ListRunnerResponse) DeepCopy() *ListRunnerResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ListRunnerResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListSchedulesRequest) DeepCopyInto(out *ListSchedulesRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListSchedulesRequest.
+func (in *ListSchedulesRequest) DeepCopy() *ListSchedulesRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ListSchedulesRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListSchedulesResponse) DeepCopyInto(out *ListSchedulesResponse) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Schedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListSchedulesResponse.
+func (in *ListSchedulesResponse) DeepCopy() *ListSchedulesResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ListSchedulesResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LockClaim) DeepCopyInto(out *LockClaim) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockClaim.
+func (in *LockClaim) DeepCopy() *LockClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(LockClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogStreamRequest) DeepCopyInto(out *LogStreamRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStreamRequest.
+func (in *LogStreamRequest) DeepCopy() *LogStreamRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LogStreamRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogStreamResponse) DeepCopyInto(out *LogStreamResponse) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStreamResponse.
+func (in *LogStreamResponse) DeepCopy() *LogStreamResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(LogStreamResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoginRequest) DeepCopyInto(out *LoginRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginRequest.
+func (in *LoginRequest) DeepCopy() *LoginRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LoginRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogoutRequest) DeepCopyInto(out *LogoutRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogoutRequest.
+func (in *LogoutRequest) DeepCopy() *LogoutRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LogoutRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MoveQueuedStepRequest) DeepCopyInto(out *MoveQueuedStepRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MoveQueuedStepRequest.
+func (in *MoveQueuedStepRequest) DeepCopy() *MoveQueuedStepRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MoveQueuedStepRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MoveQueuedStepResponse) DeepCopyInto(out *MoveQueuedStepResponse) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QueuedStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MoveQueuedStepResponse.
+func (in *MoveQueuedStepResponse) DeepCopy() *MoveQueuedStepResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(MoveQueuedStepResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PauseScheduleRequest) DeepCopyInto(out *PauseScheduleRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PauseScheduleRequest.
+func (in *PauseScheduleRequest) DeepCopy() *PauseScheduleRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PauseScheduleRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PauseScheduleResponse) DeepCopyInto(out *PauseScheduleResponse) {
+	*out = *in
+	in.Schedule.DeepCopyInto(&out.Schedule)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PauseScheduleResponse.
+func (in *PauseScheduleResponse) DeepCopy() *PauseScheduleResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(PauseScheduleResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PingRequest) DeepCopyInto(out *PingRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PingRequest.
+func (in *PingRequest) DeepCopy() *PingRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(PingRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Pipeline) DeepCopyInto(out *Pipeline) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]PipelineNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Envs != nil {
+		in, out := &in.Envs, &out.Envs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Pipeline.
+func (in *Pipeline) DeepCopy() *Pipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(Pipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PipelineNode) DeepCopyInto(out *PipelineNode) {
+	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PipelineNode.
+func (in *PipelineNode) DeepCopy() *PipelineNode {
+	if in == nil {
+		return nil
+	}
+	out := new(PipelineNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PongResponse) DeepCopyInto(out *PongResponse) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PongResponse.
+func (in *PongResponse) DeepCopy() *PongResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(PongResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuedStep) DeepCopyInto(out *QueuedStep) {
+	*out = *in
+	in.Request.DeepCopyInto(&out.Request)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuedStep.
+func (in *QueuedStep) DeepCopy() *QueuedStep {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuedStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Record) DeepCopyInto(out *Record) {
+	*out = *in
+	if in.StepInfo != nil {
+		in, out := &in.StepInfo, &out.StepInfo
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Record.
+func (in *Record) DeepCopy() *Record {
+	if in == nil {
+		return nil
+	}
+	out := new(Record)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegisterRunnerRequest) DeepCopyInto(out *RegisterRunnerRequest) {
+	*out = *in
+	in.RunnerInfo.DeepCopyInto(&out.RunnerInfo)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegisterRunnerRequest.
+func (in *RegisterRunnerRequest) DeepCopy() *RegisterRunnerRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RegisterRunnerRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegisterRunnerResponse) DeepCopyInto(out *RegisterRunnerResponse) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegisterRunnerResponse.
+func (in *RegisterRunnerResponse) DeepCopy() *RegisterRunnerResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(RegisterRunnerResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenameGroupRequest) DeepCopyInto(out *RenameGroupRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenameGroupRequest.
+func (in *RenameGroupRequest) DeepCopy() *RenameGroupRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RenameGroupRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenameGroupResponse) DeepCopyInto(out *RenameGroupResponse) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenameGroupResponse.
+func (in *RenameGroupResponse) DeepCopy() *RenameGroupResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(RenameGroupResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenameNamespaceRequest) DeepCopyInto(out *RenameNamespaceRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenameNamespaceRequest.
+func (in *RenameNamespaceRequest) DeepCopy() *RenameNamespaceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RenameNamespaceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenameNamespaceResponse) DeepCopyInto(out *RenameNamespaceResponse) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenameNamespaceResponse.
+func (in *RenameNamespaceResponse) DeepCopy() *RenameNamespaceResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(RenameNamespaceResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Request) DeepCopyInto(out *Request) {
+	*out = *in
+	out.Type = in.Type
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Request.
+func (in *Request) DeepCopy() *Request {
+	if in == nil {
+		return nil
+	}
+	out := new(Request)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceLock) DeepCopyInto(out *ResourceLock) {
+	*out = *in
+	out.Holder = in.Holder
+	if in.Waiters != nil {
+		in, out := &in.Waiters, &out.Waiters
+		*out = make([]LockClaim, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLock.
+func (in *ResourceLock) DeepCopy() *ResourceLock {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Response) DeepCopyInto(out *Response) {
+	*out = *in
+	out.Type = in.Type
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Response.
+func (in *Response) DeepCopy() *Response {
+	if in == nil {
+		return nil
+	}
+	out := new(Response)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Result) DeepCopyInto(out *Result) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Result.
+func (in *Result) DeepCopy() *Result {
+	if in == nil {
+		return nil
+	}
+	out := new(Result)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunPipelineRequest) DeepCopyInto(out *RunPipelineRequest) {
+	*out = *in
+	if in.Envs != nil {
+		in, out := &in.Envs, &out.Envs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunPipelineRequest.
+func (in *RunPipelineRequest) DeepCopy() *RunPipelineRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RunPipelineRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunPipelineResponse) DeepCopyInto(out *RunPipelineResponse) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunPipelineResponse.
+func (in *RunPipelineResponse) DeepCopy() *RunPipelineResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(RunPipelineResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunStepRequest) DeepCopyInto(out *RunStepRequest) {
+	*out = *in
+	in.Step.DeepCopyInto(&out.Step)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStepRequest.
+func (in *RunStepRequest) DeepCopy() *RunStepRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RunStepRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunStepResponse) DeepCopyInto(out *RunStepResponse) {
+	*out = *in
+	in.Item.DeepCopyInto(&out.Item)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunStepResponse.
+func (in *RunStepResponse) DeepCopy() *RunStepResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(RunStepResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerInfo) DeepCopyInto(out *RunnerInfo) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerInfo.
+func (in *RunnerInfo) DeepCopy() *RunnerInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RunnerInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunnerQueueEvent) DeepCopyInto(out *RunnerQueueEvent) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QueuedStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunnerQueueEvent.
+func (in *RunnerQueueEvent) DeepCopy() *RunnerQueueEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(RunnerQueueEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Schedule) DeepCopyInto(out *Schedule) {
+	*out = *in
+	if in.Envs != nil {
+		in, out := &in.Envs, &out.Envs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
+func (in *Schedule) DeepCopy() *Schedule {
+	if in == nil {
+		return nil
+	}
+	out := new(Schedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Step) DeepCopyInto(out *Step) {
+	*out = *in
+	if in.Envs != nil {
+		in, out := &in.Envs, &out.Envs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UploadFiles != nil {
+		in, out := &in.UploadFiles, &out.UploadFiles
+		*out = make([]UploadFile, len(*in))
+		copy(*out, *in)
+	}
+	if in.WriteFiles != nil {
+		in, out := &in.WriteFiles, &out.WriteFiles
+		*out = make([]WriteFile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Messages != nil {
+		in, out := &in.Messages, &out.Messages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Remarks != nil {
+		in, out := &in.Remarks, &out.Remarks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SharingData != nil {
+		in, out := &in.SharingData, &out.SharingData
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Retry.DeepCopyInto(&out.Retry)
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]Approval, len(*in))
+		copy(*out, *in)
+	}
+	if in.Locks != nil {
+		in, out := &in.Locks, &out.Locks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Step.
+func (in *Step) DeepCopy() *Step {
+	if in == nil {
+		return nil
+	}
+	out := new(Step)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscribeRequest) DeepCopyInto(out *SubscribeRequest) {
+	*out = *in
+	out.Subscription = in.Subscription
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscribeRequest.
+func (in *SubscribeRequest) DeepCopy() *SubscribeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscribeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscribeResponse) DeepCopyInto(out *SubscribeResponse) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Subscription, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscribeResponse.
+func (in *SubscribeResponse) DeepCopy() *SubscribeResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscribeResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Subscription) DeepCopyInto(out *Subscription) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subscription.
+func (in *Subscription) DeepCopy() *Subscription {
+	if in == nil {
+		return nil
+	}
+	out := new(Subscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Type) DeepCopyInto(out *Type) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Type.
 func (in *Type) DeepCopy() *Type {
 	if in == nil {
 		return nil
@@ -541,6 +1527,44 @@ func (in *Type) DeepCopy() *Type {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnsubscribeRequest) DeepCopyInto(out *UnsubscribeRequest) {
+	*out = *in
+	out.Subscription = in.Subscription
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnsubscribeRequest.
+func (in *UnsubscribeRequest) DeepCopy() *UnsubscribeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(UnsubscribeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnsubscribeResponse) DeepCopyInto(out *UnsubscribeResponse) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Subscription, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnsubscribeResponse.
+func (in *UnsubscribeResponse) DeepCopy() *UnsubscribeResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(UnsubscribeResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateStepRequest) DeepCopyInto(out *UpdateStepRequest) {
 	*out = *in
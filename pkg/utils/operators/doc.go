@@ -1,3 +1,3 @@
-// Package operators contains a series of steps such as Ftp, Git, Svn, Robot.
+// Package operators contains a series of steps such as Ftp, Git, Svn, Robot, Script.
 // And they were all implementing the github.com/Shanghai-Lunara/publisher/pkg/interfaces.StepOperator
 package operators
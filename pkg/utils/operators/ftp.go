@@ -1,6 +1,7 @@
 package operators
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -61,7 +62,7 @@ func (f *ftp) Prepare() {
 	f.prepareFunc()
 }
 
-func (f *ftp) Run(output chan<- string) (res []string, err error) {
+func (f *ftp) Run(ctx context.Context, output chan<- string) (res []string, err error) {
 	f.step.Phase = types.StepRunning
 	if err = f.ReloadConfig(); err != nil {
 		klog.V(2).Info(err)
@@ -93,6 +94,11 @@ func (f *ftp) Run(output chan<- string) (res []string, err error) {
 		}
 	}
 	for _, v := range f.step.UploadFiles {
+		// the uploading couldn't be interrupted, so it checks the ctx between the files
+		if ctx.Err() != nil {
+			f.step.Phase = types.StepFailed
+			return res, ctx.Err()
+		}
 		target := v.TargetFile
 		if prefix != "" {
 			target = fmt.Sprintf("%s/%s", prefix, target)
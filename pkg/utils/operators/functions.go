@@ -3,53 +3,129 @@ package operators
 import (
 	"bufio"
 	"context"
-	"fmt"
 	"io"
 	"k8s.io/klog/v2"
+	"os"
 	"os/exec"
+	"sync"
+	"time"
 )
 
-func DefaultExec(commands string) (res []byte, err error) {
-	return exec.CommandContext(context.Background(), "sh", "-c", commands).Output()
+func DefaultExec(ctx context.Context, commands string) (res []byte, err error) {
+	return exec.CommandContext(ctx, "sh", "-c", commands).Output()
 }
 
-func ExecWithStreamOutput(commands string, output chan<- string) (res []byte, err error) {
-	//return exec.CommandContext(context.Background(), "sh", "-c", commands).Output()
-	cmd := exec.CommandContext(context.Background(), "sh", "-c", commands)
-	var stdout, stderr io.ReadCloser
-	if stdout, err = cmd.StdoutPipe(); err != nil {
-		klog.V(2).Info(err)
-		return res, err
+// ExecOption sets the optional attributes of the command before starting
+type ExecOption func(cmd *exec.Cmd)
+
+// WithDir sets the working directory of the command
+func WithDir(dir string) ExecOption {
+	return func(cmd *exec.Cmd) {
+		cmd.Dir = dir
 	}
-	if stderr, err = cmd.StderrPipe(); err != nil {
-		klog.V(2).Info(err)
-		return res, err
+}
+
+// WithEnv sets the environment variables in the form "key=value" of the command.
+// The command would not inherit the environment of the Runner once the option was used.
+func WithEnv(env []string) ExecOption {
+	return func(cmd *exec.Cmd) {
+		cmd.Env = env
+	}
+}
+
+// WithArgs appends the arguments after the commands, they would be the $0, $1 and so on of the shell
+// without being parsed by it
+func WithArgs(args ...string) ExecOption {
+	return func(cmd *exec.Cmd) {
+		cmd.Args = append(cmd.Args, args...)
+	}
+}
+
+// StreamOutputDrainTimeout was the longest duration of reading the rest of the output after the commands exited,
+// because the background processes which were started by the commands might keep the pipes open
+const StreamOutputDrainTimeout = time.Second * 3
+
+// ExecWithStreamOutput runs the commands and transfers the stdout and stderr to the output line by line.
+// The commands would be started in a new process group, and the whole group would be killed when the ctx was done,
+// so that none of the child processes would be left running after the Step was cancelled.
+func ExecWithStreamOutput(ctx context.Context, commands string, output chan<- string, opts ...ExecOption) (res []byte, err error) {
+	cmd := exec.Command("sh", "-c", commands)
+	for _, opt := range opts {
+		opt(cmd)
+	}
+	setProcessGroup(cmd)
+	// the pipes were created by the os.Pipe, so that the Wait returns as soon as the sh exited
+	readers := make([]*os.File, 0, 2)
+	writers := make([]*os.File, 0, 2)
+	for i := 0; i < 2; i++ {
+		r, w, err := os.Pipe()
+		if err != nil {
+			klog.V(2).Info(err)
+			closeFiles(readers)
+			closeFiles(writers)
+			return res, err
+		}
+		readers = append(readers, r)
+		writers = append(writers, w)
 	}
-	if err = cmd.Start(); err != nil {
+	cmd.Stdout, cmd.Stderr = writers[0], writers[1]
+	err = cmd.Start()
+	// the writers were inherited by the processes of the commands, the readers would get EOF after all of them exited
+	closeFiles(writers)
+	if err != nil {
 		klog.V(2).Info(err)
+		closeFiles(readers)
 		return res, err
 	}
+	done := make(chan struct{})
+	defer close(done)
 	go func() {
-		scanner := bufio.NewScanner(stdout)
-		scanner.Split(bufio.ScanLines)
-		for scanner.Scan() {
-			m := scanner.Text()
-			output <- m
-			fmt.Println(m)
+		select {
+		case <-ctx.Done():
+			if err := killProcessGroup(cmd); err != nil {
+				klog.V(2).Info(err)
+			}
+		case <-done:
 		}
 	}()
+	var wg sync.WaitGroup
+	for _, r := range readers {
+		wg.Add(1)
+		go func(r io.Reader) {
+			defer wg.Done()
+			scanner := bufio.NewScanner(r)
+			scanner.Split(bufio.ScanLines)
+			for scanner.Scan() {
+				output <- scanner.Text()
+			}
+		}(r)
+	}
+	err = cmd.Wait()
+	drained := make(chan struct{})
 	go func() {
-		scanner := bufio.NewScanner(stderr)
-		scanner.Split(bufio.ScanLines)
-		for scanner.Scan() {
-			m := scanner.Text()
-			output <- m
-			fmt.Println(m)
-		}
+		wg.Wait()
+		close(drained)
 	}()
-	if err = cmd.Wait(); err != nil {
+	select {
+	case <-drained:
+	case <-time.After(StreamOutputDrainTimeout):
+		klog.Infof("the output of the commands wasn't closed after exiting for %v, the rest would be dropped", StreamOutputDrainTimeout)
+	}
+	closeFiles(readers)
+	if err != nil {
 		klog.V(2).Info(err)
+		if ctx.Err() != nil {
+			return res, ctx.Err()
+		}
 		return res, err
 	}
 	return res, nil
 }
+
+func closeFiles(files []*os.File) {
+	for _, f := range files {
+		if err := f.Close(); err != nil {
+			klog.V(2).Info(err)
+		}
+	}
+}
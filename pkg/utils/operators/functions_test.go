@@ -0,0 +1,50 @@
+package operators
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestExecWithStreamOutput(t *testing.T) {
+	type args struct {
+		commands string
+		cancelIn time.Duration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name:    "TestExecWithStreamOutput_1",
+			args:    args{commands: "echo publisher", cancelIn: time.Second * 10},
+			wantErr: nil,
+		},
+		{
+			name:    "TestExecWithStreamOutput_2",
+			args:    args{commands: "sleep 30 & sleep 30; echo publisher", cancelIn: time.Millisecond * 100},
+			wantErr: context.Canceled,
+		},
+		{
+			name:    "TestExecWithStreamOutput_3",
+			args:    args{commands: "sleep 10 & echo publisher", cancelIn: time.Second * 10},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			time.AfterFunc(tt.args.cancelIn, cancel)
+			start := time.Now()
+			_, err := ExecWithStreamOutput(ctx, tt.args.commands, make(chan string, 4096))
+			if err != tt.wantErr {
+				t.Errorf("ExecWithStreamOutput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if time.Since(start) > time.Second*5 {
+				t.Errorf("ExecWithStreamOutput() was not killed in time")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package operators
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -30,6 +31,7 @@ func NewGit(gitDir string, branchName string) *Git {
 }
 
 type Git struct {
+	ctx    context.Context
 	output chan<- string
 	step   *types.Step
 }
@@ -46,12 +48,17 @@ func (g *Git) Prepare() {
 
 }
 
-func (g *Git) Run(output chan<- string) (res []string, err error) {
+func (g *Git) Run(ctx context.Context, output chan<- string) (res []string, err error) {
+	g.ctx = ctx
 	g.output = output
 	g.step.Phase = types.StepRunning
 	var out []byte
 	if out, err = g.fetchAll(); err != nil {
 		klog.V(2).Info(err)
+		if ctx.Err() != nil {
+			g.step.Phase = types.StepFailed
+			return res, ctx.Err()
+		}
 		//g.step.Phase = types.StepFailed
 		//return res, err
 	}
@@ -90,14 +97,22 @@ func (g *Git) Run(output chan<- string) (res []string, err error) {
 	return res, nil
 }
 
+// context returns the ctx of the running Step, the exported commands may be called without running
+func (g *Git) context() context.Context {
+	if g.ctx == nil {
+		return context.Background()
+	}
+	return g.ctx
+}
+
 func (g *Git) cd() (res []byte, err error) {
 	commands := fmt.Sprintf("cd %s", g.step.Envs[types.PublisherProjectDir])
-	return DefaultExec(commands)
+	return DefaultExec(g.context(), commands)
 }
 
 func (g *Git) branch() (res string, err error) {
 	commands := fmt.Sprintf("cd %s && Git branch -a | grep '*'", g.step.Envs[types.PublisherProjectDir])
-	t, err := DefaultExec(commands)
+	t, err := DefaultExec(g.context(), commands)
 	if err != nil {
 		klog.V(2).Info(err)
 		return res, err
@@ -119,34 +134,34 @@ func (g *Git) branch() (res string, err error) {
 
 func (g *Git) fetchAll() (res []byte, err error) {
 	commands := fmt.Sprintf("cd %s && Git fetch --all && Git fetch -p", g.step.Envs[types.PublisherProjectDir])
-	return ExecWithStreamOutput(commands, g.output)
+	return ExecWithStreamOutput(g.context(), commands, g.output)
 }
 
 func (g *Git) revert() (res []byte, err error) {
 	commands := fmt.Sprintf("cd %s && Git add --all && Git checkout -f && Git reset --hard", g.step.Envs[types.PublisherProjectDir])
-	return ExecWithStreamOutput(commands, g.output)
+	return ExecWithStreamOutput(g.context(), commands, g.output)
 }
 
 func (g *Git) checkout() (res []byte, err error) {
 	commands := fmt.Sprintf("cd %s && Git checkout -B %s --track remotes/origin/%s",
 		g.step.Envs[types.PublisherProjectDir], g.step.Envs[types.PublisherGitBranch], g.step.Envs[types.PublisherGitBranch])
 	klog.Info("Git checkout commands:", commands)
-	return ExecWithStreamOutput(commands, g.output)
+	return ExecWithStreamOutput(g.context(), commands, g.output)
 }
 
 func (g *Git) pull() (res []byte, err error) {
 	commands := fmt.Sprintf("cd %s && Git pull", g.step.Envs[types.PublisherProjectDir])
-	return ExecWithStreamOutput(commands, g.output)
+	return ExecWithStreamOutput(g.context(), commands, g.output)
 }
 
 func (g *Git) AddAll(output chan<- string) (res []byte, err error) {
 	commands := fmt.Sprintf("cd %s && Git add --all", g.step.Envs[types.PublisherProjectDir])
-	return ExecWithStreamOutput(commands, output)
+	return ExecWithStreamOutput(g.context(), commands, output)
 }
 
 func (g *Git) Push(output chan<- string) (res []byte, err error) {
 	commands := fmt.Sprintf("cd %s && Git push", g.step.Envs[types.PublisherProjectDir])
-	return ExecWithStreamOutput(commands, output)
+	return ExecWithStreamOutput(g.context(), commands, output)
 }
 
 func (g *Git) Commit(output chan<- string, content, source, branch, hash string) (res []byte, err error) {
@@ -161,12 +176,12 @@ Hash: %s"`,
 		branch,
 		hash)
 	klog.Info("Git Commit commands:", commands)
-	return ExecWithStreamOutput(commands, output)
+	return ExecWithStreamOutput(g.context(), commands, output)
 }
 
 func (g *Git) source() (res []byte, err error) {
 	commands := fmt.Sprintf(`cd %s && cat .git/config | grep url`, g.step.Envs[types.PublisherProjectDir])
-	res, err = DefaultExec(commands)
+	res, err = DefaultExec(g.context(), commands)
 	if err == nil {
 		g.step.Envs[types.PublisherGitSource] = string(res)
 	}
@@ -176,7 +191,7 @@ func (g *Git) source() (res []byte, err error) {
 
 func (g *Git) getCommitHash() (res []byte, err error) {
 	commands := fmt.Sprintf(`cd %s && git log -p -1 | grep commit | grep -v -i hash`, g.step.Envs[types.PublisherProjectDir])
-	res, err = DefaultExec(commands)
+	res, err = DefaultExec(g.context(), commands)
 	if err == nil {
 		g.step.Envs[types.PublisherGitCommitHash] = string(res)
 	}
@@ -1,6 +1,7 @@
 package operators
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -91,7 +92,7 @@ func Test_git_Run(t *testing.T) {
 				output: make(chan<- string, 4096),
 				step:   tt.fields.step,
 			}
-			gotRes, err := g.Run(make(chan<- string, 4096))
+			gotRes, err := g.Run(context.Background(), make(chan<- string, 4096))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Git.Run() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -160,7 +161,7 @@ func Test_git_exec(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotRes, err := DefaultExec(tt.args.commands)
+			gotRes, err := DefaultExec(context.Background(), tt.args.commands)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Git.exec() error = %v, wantErr %v", err, tt.wantErr)
 				return
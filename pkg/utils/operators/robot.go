@@ -1,6 +1,7 @@
 package operators
 
 import (
+	"context"
 	"fmt"
 	"github.com/Shanghai-Lunara/publisher/pkg/types"
 )
@@ -45,6 +46,6 @@ func (r *robot) Prepare() {
 	r.prepareFunc()
 }
 
-func (r *robot) Run(output chan<- string) (res []string, err error) {
+func (r *robot) Run(ctx context.Context, output chan<- string) (res []string, err error) {
 	return res, nil
 }
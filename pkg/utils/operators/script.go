@@ -0,0 +1,123 @@
+package operators
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// NewScript returns a StepOperator which runs the shell commands, or the script file if the commands was empty.
+// The Envs and the SharingData of the Step would be exported as the environment variables of the process,
+// and only the Runner's own environment variables in the envAllowList would be inherited, such as PATH and HOME.
+func NewScript(name, commands, scriptFile, workDir string, envAllowList []string) *script {
+	return &script{
+		commands:     commands,
+		scriptFile:   scriptFile,
+		workDir:      workDir,
+		envAllowList: envAllowList,
+		step: &types.Step{
+			Id:             0,
+			Name:           name,
+			Phase:          types.StepPending,
+			Policy:         types.StepPolicyManual,
+			Available:      types.StepAvailableEnable,
+			Envs:           make(map[string]string, 0),
+			Messages:       make([]string, 0),
+			Output:         make([]string, 0),
+			SharingData:    make(map[string]string, 0),
+			SharingSetting: false,
+		},
+	}
+}
+
+const (
+	ScriptCommandStart = "start"
+	ScriptExitCode     = "exit code %d"
+)
+
+// script implements github.com/Shanghai-Lunara/publisher/pkg/interfaces.StepOperator
+// The commands were fixed by the Runner configuration, so they couldn't be modified from the dashboard.
+type script struct {
+	commands     string
+	scriptFile   string
+	workDir      string
+	envAllowList []string
+	step         *types.Step
+}
+
+func (s *script) Step() *types.Step {
+	return s.step
+}
+
+func (s *script) Update(step *types.Step) {
+	s.step = step.DeepCopy()
+}
+
+func (s *script) Prepare() {
+	s.step.Messages = make([]string, 0)
+	s.step.Remarks = make([]string, 0)
+}
+
+func (s *script) Run(ctx context.Context, output chan<- string) (res []string, err error) {
+	s.step.Phase = types.StepRunning
+	s.step.Messages = append(s.step.Messages, types.StepMessage(s.step.Name, ScriptCommandStart))
+	commands := s.commands
+	opts := []ExecOption{WithEnv(s.environ())}
+	if commands == "" {
+		// the path of the script file was passed as the $0, so that it wouldn't be parsed by the shell
+		commands = `exec sh "$0"`
+		opts = append(opts, WithArgs(s.scriptFile))
+	}
+	if s.workDir != "" {
+		opts = append(opts, WithDir(s.workDir))
+	}
+	if _, err = ExecWithStreamOutput(ctx, commands, output, opts...); err != nil {
+		klog.V(2).Info(err)
+		s.step.Phase = types.StepFailed
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			s.step.Remarks = append(s.step.Remarks, fmt.Sprintf(ScriptExitCode, exitErr.ExitCode()))
+		}
+		return res, err
+	}
+	s.step.Remarks = append(s.step.Remarks, fmt.Sprintf(ScriptExitCode, 0))
+	s.step.Phase = types.StepSucceeded
+	return res, nil
+}
+
+// environ returns the environment variables of the process.
+// The SharingData would be overridden by the Envs if they had the same key.
+func (s *script) environ() []string {
+	items := make(map[string]string, 0)
+	for _, k := range s.envAllowList {
+		if v, ok := os.LookupEnv(k); ok {
+			items[k] = v
+		}
+	}
+	for k, v := range s.step.SharingData {
+		items[k] = v
+	}
+	for k, v := range s.step.Envs {
+		items[k] = v
+	}
+	keys := make([]string, 0)
+	for k := range items {
+		// the key couldn't be exported as an environment variable
+		if k == "" || strings.ContainsAny(k, "= \t\n") {
+			klog.V(2).Infof("script step:%s skip the invalid env key:%q", s.step.Name, k)
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	env := make([]string, 0)
+	for _, k := range keys {
+		env = append(env, fmt.Sprintf("%s=%s", k, items[k]))
+	}
+	return env
+}
@@ -0,0 +1,93 @@
+package operators
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Shanghai-Lunara/publisher/pkg/types"
+)
+
+func Test_script_Run(t *testing.T) {
+	type fields struct {
+		commands string
+		// scriptFile was created in a temporary dir with the content of the commands
+		scriptFile  string
+		envs        map[string]string
+		sharingData map[string]string
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantOutput string
+		wantPhase  types.StepPhase
+		wantErr    bool
+	}{
+		{
+			name: "Test_script_Run_1",
+			fields: fields{
+				commands:    `echo "$PUBLISHER_GIT_BRANCH $VERSION"`,
+				envs:        map[string]string{types.PublisherGitBranch: "main", "VERSION": "1.0.0"},
+				sharingData: map[string]string{"VERSION": "0.0.1"},
+			},
+			wantOutput: "main 1.0.0",
+			wantPhase:  types.StepSucceeded,
+			wantErr:    false,
+		},
+		{
+			name: "Test_script_Run_2",
+			fields: fields{
+				commands: `echo "$1"; exit 3`,
+				envs:     map[string]string{"INJECT": "; exit 0"},
+			},
+			wantOutput: "",
+			wantPhase:  types.StepFailed,
+			wantErr:    true,
+		},
+		{
+			name: "Test_script_Run_3",
+			fields: fields{
+				commands:   `echo "file $VERSION"`,
+				scriptFile: "it's a script; echo injected.sh",
+				envs:       map[string]string{"VERSION": "1.0.0"},
+			},
+			wantOutput: "file 1.0.0",
+			wantPhase:  types.StepSucceeded,
+			wantErr:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands, scriptFile := tt.fields.commands, ""
+			if tt.fields.scriptFile != "" {
+				dir, err := ioutil.TempDir("", "script")
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer os.RemoveAll(dir)
+				scriptFile = filepath.Join(dir, tt.fields.scriptFile)
+				if err = ioutil.WriteFile(scriptFile, []byte(commands), 0644); err != nil {
+					t.Fatal(err)
+				}
+				commands = ""
+			}
+			s := NewScript("Script-Operator", commands, scriptFile, "", nil)
+			s.Step().Envs = tt.fields.envs
+			s.Step().SharingData = tt.fields.sharingData
+			output := make(chan string, 4096)
+			_, err := s.Run(context.Background(), output)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("script.Run() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if s.Step().Phase != tt.wantPhase {
+				t.Errorf("script.Run() phase = %v, want %v", s.Step().Phase, tt.wantPhase)
+			}
+			if got := <-output; got != tt.wantOutput {
+				t.Errorf("script.Run() output = %v, want %v", got, tt.wantOutput)
+			}
+		})
+	}
+}
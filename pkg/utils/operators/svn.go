@@ -1,6 +1,7 @@
 package operators
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"github.com/Shanghai-Lunara/publisher/pkg/interfaces"
@@ -20,6 +21,7 @@ func NewSvn(host string, port int, username, password, remoteDir, workDir string
 	envs[types.PublisherSvnCommitMessage] = "Automate Runner"
 	envs[types.PublisherSvnCommand] = SvnCommandWaiting
 	return &svn{
+		ctx: context.Background(),
 		step: &types.Step{
 			Id:             0,
 			Name:           "SVN-Operator",
@@ -36,6 +38,7 @@ func NewSvn(host string, port int, username, password, remoteDir, workDir string
 }
 
 type svn struct {
+	ctx    context.Context
 	output chan<- string
 	step   *types.Step
 }
@@ -69,7 +72,8 @@ func (s *svn) AppendMessage(action string) {
 	s.step.Messages = append(s.step.Messages, types.StepMessage(s.step.Name, action))
 }
 
-func (s *svn) Run(output chan<- string) (res []string, err error) {
+func (s *svn) Run(ctx context.Context, output chan<- string) (res []string, err error) {
+	s.ctx = ctx
 	s.output = output
 	s.step.Phase = types.StepRunning
 	var out []byte
@@ -140,7 +144,7 @@ func (s *svn) Run(output chan<- string) (res []string, err error) {
 
 func (s *svn) cd() (res []byte, err error) {
 	commands := fmt.Sprintf("cd %s", s.step.Envs[types.PublisherSvnWorkDir])
-	return DefaultExec(commands)
+	return DefaultExec(s.ctx, commands)
 }
 
 const svnUrl = "svn://%s@%s:%s/%s"
@@ -156,7 +160,7 @@ func (s *svn) checkout() (res []byte, err error) {
 			s.step.Envs[types.PublisherSvnPort],
 			s.step.Envs[types.PublisherSvnRemoteDir]),
 	)
-	return ExecWithStreamOutput(commands, s.output)
+	return ExecWithStreamOutput(s.ctx, commands, s.output)
 }
 
 func (s *svn) addAll() (res []byte, err error) {
@@ -167,7 +171,7 @@ func (s *svn) addAll() (res []byte, err error) {
 		s.step.Envs[types.PublisherSvnUsername],
 		s.step.Envs[types.PublisherSvnPassword],
 	)
-	return ExecWithStreamOutput(commands, s.output)
+	return ExecWithStreamOutput(s.ctx, commands, s.output)
 }
 
 func (s *svn) revertAll() (res []byte, err error) {
@@ -178,7 +182,7 @@ func (s *svn) revertAll() (res []byte, err error) {
 		s.step.Envs[types.PublisherSvnUsername],
 		s.step.Envs[types.PublisherSvnPassword],
 	)
-	return ExecWithStreamOutput(commands, s.output)
+	return ExecWithStreamOutput(s.ctx, commands, s.output)
 }
 
 func (s *svn) removeAll() (res []byte, err error) {
@@ -187,7 +191,7 @@ func (s *svn) removeAll() (res []byte, err error) {
 		s.step.Envs[types.PublisherSvnUsername],
 		s.step.Envs[types.PublisherSvnPassword],
 	)
-	return ExecWithStreamOutput(commands, s.output)
+	return ExecWithStreamOutput(s.ctx, commands, s.output)
 }
 
 func (s *svn) commit() (res []byte, err error) {
@@ -198,7 +202,7 @@ func (s *svn) commit() (res []byte, err error) {
 		s.step.Envs[types.PublisherSvnCommitMessage],
 		s.step.Envs[types.PublisherSvnUsername],
 	)
-	return ExecWithStreamOutput(commands, s.output)
+	return ExecWithStreamOutput(s.ctx, commands, s.output)
 }
 
 type LogResponse struct {
@@ -236,7 +240,7 @@ func (s *svn) log(number int) (res []byte, err error) {
 		s.step.Envs[types.PublisherSvnPassword],
 		number,
 	)
-	res, err = DefaultExec(commands)
+	res, err = DefaultExec(s.ctx, commands)
 	if err != nil {
 		klog.V(2).Info(err)
 		return nil, err